@@ -1,6 +1,10 @@
 package loafer
 
 const (
+	// RESPONSEINCHANNEL - response_type of a message visible to the whole channel
+	RESPONSEINCHANNEL = "in_channel"
+	// RESPONSEEPHEMERAL - response_type of a message only visible to the user
+	RESPONSEEPHEMERAL = "ephemeral"
 	// INSTALLSUCCESSPAGE - Default Installation Page
 	INSTALLSUCCESSPAGE = `
 		<!DOCTYPE html>
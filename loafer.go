@@ -23,12 +23,16 @@ type SlackApp struct {
 	actionListeners   map[string]func(ctx *SlackContext)                                                     // List of action handlers
 	submitListeners   map[string]func(ctx *SlackContext)                                                     // List of view submission handlers
 	closeListeners    map[string]func(ctx *SlackContext)                                                     // List of view close handlers
+	metadataListeners map[string]func(ctx *SlackContext, event SlackMetadataEvent)                           // List of message metadata event handlers
+	linkListeners     []slackLinkListener                                                                    // List of link unfurl handlers, in registration order
 }
 
 // SlackAuthToken - Slack App Auth Token
 type SlackAuthToken struct {
-	Workspace string
-	Token     string
+	Workspace       string
+	Token           string
+	User            string                      // User the token acts for, empty for the bot token
+	IncomingWebhook *SlackOauth2IncomingWebhook // Set when installed with the incoming-webhook scope
 }
 
 // SlackAppOptions - Slack App options
@@ -39,14 +43,17 @@ type SlackAppOptions struct {
 	ClientSecret  string                                  // App client secret
 	ClientID      string                                  // App client id
 	SigningSecret string                                  // Signning secret
+	VerifyInstall bool                                    // Check freshly issued tokens with auth.test during install
 }
 
 // SlackContext - Slack request context
 type SlackContext struct {
-	Body  []byte
-	Token string
-	Req   *http.Request
-	Res   http.ResponseWriter
+	Body      []byte
+	Token     string
+	UserToken string // Token of the acting user, empty unless the user granted user scopes
+	Workspace string
+	Req       *http.Request
+	Res       http.ResponseWriter
 }
 
 // SlackOauth2Team - Slack App Access Response Team
@@ -63,17 +70,46 @@ type SlackOauth2User struct {
 	TokenType   string `json:"token_type"`
 }
 
+// SlackOauth2IncomingWebhook - Slack App Access Response Incoming Webhook
+type SlackOauth2IncomingWebhook struct {
+	Channel          string `json:"channel"`
+	ChannelID        string `json:"channel_id"`
+	ConfigurationURL string `json:"configuration_url"`
+	URL              string `json:"url"`
+}
+
 // SlackOauth2Response - Slack App Access Response
 type SlackOauth2Response struct {
-	Ok          bool            `json:"ok"`
-	AccessToken string          `json:"access_token"`
-	TokenType   string          `json:"token_type"`
-	Scope       string          `json:"scope"`
-	BotUserID   string          `json:"bot_user_id"`
-	AppID       string          `json:"app_id"`
-	Team        SlackOauth2Team `json:"team"`
-	Enterprise  SlackOauth2Team `json:"enterprise"`
-	AuthedUser  SlackOauth2User `json:"authed_user"`
+	Ok              bool                        `json:"ok"`
+	AccessToken     string                      `json:"access_token"`
+	TokenType       string                      `json:"token_type"`
+	Scope           string                      `json:"scope"`
+	BotUserID       string                      `json:"bot_user_id"`
+	AppID           string                      `json:"app_id"`
+	Team            SlackOauth2Team             `json:"team"`
+	Enterprise      SlackOauth2Team             `json:"enterprise"`
+	AuthedUser      SlackOauth2User             `json:"authed_user"`
+	IncomingWebhook *SlackOauth2IncomingWebhook `json:"incoming_webhook"`
+	Identity        *SlackAuthIdentity          `json:"-"` // auth.test result, set when VerifyInstall is on
+}
+
+// AuthToken - Installation record to persist and serve back from TokensCache
+func (r *SlackOauth2Response) AuthToken() SlackAuthToken {
+	return SlackAuthToken{
+		Workspace:       r.Team.ID,
+		Token:           r.AccessToken,
+		IncomingWebhook: r.IncomingWebhook}
+}
+
+// UserAuthToken - User token record to persist and serve back from TokensCache, false when no user scopes were granted
+func (r *SlackOauth2Response) UserAuthToken() (SlackAuthToken, bool) {
+	if len(r.AuthedUser.AccessToken) == 0 {
+		return SlackAuthToken{}, false
+	}
+	return SlackAuthToken{
+		Workspace: r.Team.ID,
+		Token:     r.AuthedUser.AccessToken,
+		User:      r.AuthedUser.ID}, true
 }
 
 // OnCommand - Add handler to command
@@ -135,16 +171,18 @@ func (a *SlackApp) appInstall(res http.ResponseWriter, req *http.Request) {
 	form.Set("code", req.URL.Query().Get("code"))
 	form.Set("client_id", a.opts.ClientID)
 	form.Set("client_secret", a.opts.ClientSecret)
-	resp, err := http.Post("https://slack.com/api/oauth.v2.access", "application/x-www-form-urlencoded", strings.NewReader(form.Encode()))
-	if err != nil {
+	err := InitializeSlackAPIClient("", nil).Call(context.Background(), "oauth.v2.access", form, &installResponse)
+	if _, isAPIErr := err.(*SlackAPIError); err != nil && !isAPIErr {
 		Response(&SlackContext{Res: res}, http.StatusInternalServerError, []byte("Unable to authorize Slack App for workspace"), nil)
 		return
 	}
-	defer resp.Body.Close()
-	err = json.NewDecoder(resp.Body).Decode(&installResponse)
-	if err != nil {
-		Response(&SlackContext{Res: res}, http.StatusInternalServerError, []byte("Unable to get Slack OAuth2 Access Response for workspace"), nil)
-		return
+	if installResponse.Ok && a.opts.VerifyInstall {
+		identity, err := AuthTest(installResponse.AccessToken)
+		if err != nil {
+			Response(&SlackContext{Res: res}, http.StatusInternalServerError, []byte("Unable to verify Slack App token for workspace"), nil)
+			return
+		}
+		installResponse.Identity = &identity
 	}
 	if installResponse.Ok {
 		avoidDefaultPage := false
@@ -196,17 +234,30 @@ func (a *SlackApp) interactions(res http.ResponseWriter, req *http.Request) {
 			Response(&SlackContext{Res: res}, http.StatusBadRequest, []byte("Invalid JSON format"), nil)
 			return
 		}
-		accessToken := a.findTokenForWorkspace(event.Team.ID)
+		if event.View != nil {
+			if event.Type == "view_closed" {
+				forgetView(event.View.ID)
+			} else {
+				trackView(*event.View)
+			}
+		}
+		actingUser := ""
+		if event.User != nil {
+			actingUser = event.User.ID
+		}
+		accessToken, userToken := a.findTokens(event.Team.ID, actingUser)
 		if accessToken == nil {
 			fmt.Printf("App not installed for workspace: %s\n", queries.Get("team_id"))
 			Response(&SlackContext{Res: res}, http.StatusBadRequest, []byte("App not installed for workspace"), nil)
 			return
 		}
 		ctx := &SlackContext{
-			Body:  bodyText,
-			Token: accessToken.Token,
-			Res:   res,
-			Req:   req}
+			Body:      bodyText,
+			Token:     accessToken.Token,
+			UserToken: userToken,
+			Workspace: event.Team.ID,
+			Res:       res,
+			Req:       req}
 		switch Type := event.Type; Type {
 		case "shortcut":
 			callbackID := event.CallbackID
@@ -267,17 +318,19 @@ func (a *SlackApp) commands(res http.ResponseWriter, req *http.Request) {
 	}
 	isAuthorizedCaller := a.checkSlackSecret(req.Header.Get("X-Slack-Signature"), req.Header.Get("X-Slack-Request-TimeStamp"), string(bodyText))
 	if isAuthorizedCaller {
-		accessToken := a.findTokenForWorkspace(queries.Get("team_id"))
+		accessToken, userToken := a.findTokens(queries.Get("team_id"), queries.Get("user_id"))
 		if accessToken == nil {
 			fmt.Printf("App not installed for workspace: %s\n", queries.Get("team_id"))
 			Response(&SlackContext{Res: res}, http.StatusBadRequest, []byte("App not installed for workspace"), nil)
 			return
 		}
 		ctx := &SlackContext{
-			Body:  bodyText,
-			Token: accessToken.Token,
-			Res:   res,
-			Req:   req}
+			Body:      bodyText,
+			Token:     accessToken.Token,
+			UserToken: userToken,
+			Workspace: queries.Get("team_id"),
+			Res:       res,
+			Req:       req}
 		if accessToken == nil {
 			Response(ctx, http.StatusBadRequest, []byte("Unrecognized workspace"), nil)
 			return
@@ -299,16 +352,32 @@ func (a *SlackApp) index(res http.ResponseWriter, req *http.Request) {
 	Response(&SlackContext{Res: res}, http.StatusOK, nil, nil)
 }
 
+// routes - Register the app routes on mux
+func (a *SlackApp) routes(mux *http.ServeMux) {
+	mux.HandleFunc("/", a.index)
+	mux.HandleFunc(fmt.Sprintf("/%s/install", a.opts.Prefix), a.appInstall)
+	mux.HandleFunc(fmt.Sprintf("/%s/commands", a.opts.Prefix), a.commands)
+	mux.HandleFunc(fmt.Sprintf("/%s/events", a.opts.Prefix), a.events)
+	mux.HandleFunc(fmt.Sprintf("/%s/", a.opts.Prefix), a.interactions)
+}
+
+// Handler - Return a handler serving the app routes, to mount the app in your own server instead of ServeApp
+func (a *SlackApp) Handler() http.Handler {
+	if len(a.opts.Prefix) == 0 {
+		panic(fmt.Sprintf("\x1b[31m%s\x1b[0m\n", "Slack App Route Prefix Cannot Be Empty"))
+	}
+	mux := http.NewServeMux()
+	a.routes(mux)
+	return mux
+}
+
 // ServeApp - Listen and Serve App on desired port, callback can be nil
 func (a *SlackApp) ServeApp(port uint16, cb func()) {
 	if len(a.opts.Prefix) == 0 {
 		panic(fmt.Sprintf("\x1b[31m%s\x1b[0m\n", "Slack App Route Prefix Cannot Be Empty"))
 	}
 	a.server = &http.Server{Addr: fmt.Sprintf(":%d", port)}
-	http.HandleFunc("/", a.index)
-	http.HandleFunc(fmt.Sprintf("/%s/install", a.opts.Prefix), a.appInstall)
-	http.HandleFunc(fmt.Sprintf("/%s/commands", a.opts.Prefix), a.commands)
-	http.HandleFunc(fmt.Sprintf("/%s/", a.opts.Prefix), a.interactions)
+	a.routes(http.DefaultServeMux)
 	if cb != nil {
 		go cb()
 	}
@@ -333,27 +402,51 @@ func InitializeSlackApp(opts *SlackAppOptions) SlackApp {
 			Prefix:        opts.Prefix,
 			ClientSecret:  opts.ClientSecret,
 			ClientID:      opts.ClientID,
-			SigningSecret: opts.SigningSecret},
-		distCB:          nil,
-		cmds:            make(map[string]func(ctx *SlackContext)),
-		actionListeners: make(map[string]func(ctx *SlackContext)),
-		submitListeners: make(map[string]func(ctx *SlackContext)),
-		closeListeners:  make(map[string]func(ctx *SlackContext)),
+			SigningSecret: opts.SigningSecret,
+			VerifyInstall: opts.VerifyInstall},
+		distCB:            nil,
+		cmds:              make(map[string]func(ctx *SlackContext)),
+		actionListeners:   make(map[string]func(ctx *SlackContext)),
+		submitListeners:   make(map[string]func(ctx *SlackContext)),
+		closeListeners:    make(map[string]func(ctx *SlackContext)),
+		metadataListeners: make(map[string]func(ctx *SlackContext, event SlackMetadataEvent)),
 	}
 	return app
 }
 
-// findTokenForWorkspace - Finding the token for the corresponding workspace
+// findTokenForWorkspace - Finding the bot token for the corresponding workspace
 func (a *SlackApp) findTokenForWorkspace(workspace string) *SlackAuthToken {
+	botToken, _ := a.findTokens(workspace, "")
+	return botToken
+}
+
+// findTokens - Finding the bot token and the token user granted, empty if none, with one read of the tokens cache
+func (a *SlackApp) findTokens(workspace string, user string) (*SlackAuthToken, string) {
+	var botToken *SlackAuthToken
+	userToken := ""
 	availableTokens := a.opts.TokensCache(workspace)
-	var token *SlackAuthToken
-	for _, t := range availableTokens {
-		if t.Workspace == workspace {
-			token = &t
-			break
+	for i := range availableTokens {
+		if availableTokens[i].Workspace != workspace {
+			continue
 		}
+		if len(availableTokens[i].User) == 0 {
+			if botToken == nil {
+				botToken = &availableTokens[i]
+			}
+		} else if len(user) > 0 && availableTokens[i].User == user && len(userToken) == 0 {
+			userToken = availableTokens[i].Token
+		}
+	}
+	return botToken, userToken
+}
+
+// PostToWorkspaceWebhook - Post a message to the incoming webhook installed for workspace
+func (a *SlackApp) PostToWorkspaceWebhook(workspace string, msg SlackWebhookMessage) error {
+	accessToken := a.findTokenForWorkspace(workspace)
+	if accessToken == nil || accessToken.IncomingWebhook == nil {
+		return fmt.Errorf("no incoming webhook installed for workspace %s", workspace)
 	}
-	return token
+	return PostWebhook(accessToken.IncomingWebhook.URL, msg)
 }
 
 // Response - Send response back to slack
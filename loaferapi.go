@@ -1,261 +1,268 @@
 package loafer
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
-	"log"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 )
 
-// SlackUsersQuery - Slack User query
-type SlackUsersQuery struct {
-	Ok    bool      `json:"ok"`
-	User  SlackUser `json:"user"`
-	Error string    `json:"error"`
+// SlackAPIError - Error returned by a Slack API method
+type SlackAPIError struct {
+	Method string // Slack API method, e.g. conversations.create
+	Code   string // Slack error code, e.g. channel_not_found
 }
 
-// SlackUser - Slack User
-type SlackUser struct {
-	ID       string `json:"id"`
-	TeamID   string `json:"team_id"`
-	Name     string `json:"name"`
-	Deleted  bool   `json:"deleted"`
-	Color    string `json:"color"`
-	RealName string `json:"real_name"`
-	TZ       string `json:"tz"`
-	TZLabel  string `json:"tz_label"`
-	TZOffset int32  `json:"tz_offset"`
-	Profile  struct {
-		AvatarHash            string `json:"avatar_hash"`
-		StatusText            string `json:"status_text"`
-		StatusEmoji           string `json:"status_emoji"`
-		RealName              string `json:"real_name"`
-		DisplayName           string `json:"display_name"`
-		RealNameNormalized    string `json:"real_name_normalized"`
-		DisplayNameNormalized string `json:"display_name_normalized"`
-		Email                 string `json:"email"`
-		Image24               string `json:"image_24"`
-		Image32               string `json:"image_32"`
-		Image48               string `json:"image_48"`
-		Image72               string `json:"image_72"`
-		Image192              string `json:"image_192"`
-		Image512              string `json:"image_512"`
-		Team                  string `json:"team"`
-	} `json:"profile"`
-	IsAdmin           bool   `json:"is_admin"`
-	IsOwner           bool   `json:"is_owner"`
-	IsPrimaryOwner    bool   `json:"is_primary_owner"`
-	IsRestricted      bool   `json:"is_restricted"`
-	IsUltraRestricted bool   `json:"is_ultra_restricted"`
-	IsBot             bool   `json:"is_bot"`
-	Updated           uint32 `json:"updated"`
-	IsAppUser         bool   `json:"is_app_user"`
-	Has2FA            bool   `json:"has_2fa"`
+// Error - Describe the failed Slack API call
+func (e *SlackAPIError) Error() string {
+	return fmt.Sprintf("slack %s: %s", e.Method, e.Code)
 }
 
-// slackUserQuery - Calls Slack Users.info or Users.lookupByEmail API
-func slackUserCall(uri string, token string) SlackUser {
-	var userQuery SlackUsersQuery
-	client := &http.Client{}
-	r, err := http.NewRequest("GET", uri, nil)
-	if err != nil {
-		return userQuery.User
-	}
-	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
-	r.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
-	resp, err := client.Do(r)
-	if err != nil {
-		return userQuery.User
+// SlackResponseMetadata - Slack cursor pagination metadata
+type SlackResponseMetadata struct {
+	NextCursor string `json:"next_cursor"`
+}
+
+// slackAPIResponse - Fields shared by every Slack API response
+type slackAPIResponse struct {
+	Ok    bool   `json:"ok"`
+	Error string `json:"error"`
+}
+
+const (
+	slackAPIURL            = "https://slack.com/api/" // Base URL of the Slack Web API
+	slackMaxRetries        = 3                        // Retries of a rate limited request
+	slackDefaultRetryAfter = time.Second              // Wait before retrying when Slack gives no Retry-After
+)
+
+// SlackAPIClientOptions - Slack API client options
+type SlackAPIClientOptions struct {
+	BaseURL     string       // Base URL of the Slack Web API, defaults to https://slack.com/api/
+	HTTPClient  *http.Client // HTTP client sending the requests, defaults to a new client per call
+	MaxRetries  int          // Retries of a rate limited request, defaults to 3, negative disables retries
+	FormMethods []string     // Methods not accepting JSON, their JSON params are sent form encoded instead
+}
+
+// SlackAPIClient - Slack Web API client acting with a token
+type SlackAPIClient struct {
+	token string
+	opts  SlackAPIClientOptions
+}
+
+// defaultClientOptions - Options of the clients used by the package level API calls
+var defaultClientOptions = struct {
+	sync.RWMutex
+	opts SlackAPIClientOptions
+}{}
+
+// SetDefaultAPIClientOptions - Set the options of the clients used by the package level API calls
+func SetDefaultAPIClientOptions(opts SlackAPIClientOptions) {
+	defaultClientOptions.Lock()
+	defer defaultClientOptions.Unlock()
+	defaultClientOptions.opts = opts
+}
+
+// InitializeSlackAPIClient - Return an API client acting with token, opts can be nil for the default options
+func InitializeSlackAPIClient(token string, opts *SlackAPIClientOptions) *SlackAPIClient {
+	client := &SlackAPIClient{token: token}
+	if opts != nil {
+		client.opts = *opts
+	} else {
+		defaultClientOptions.RLock()
+		client.opts = defaultClientOptions.opts
+		defaultClientOptions.RUnlock()
 	}
-	text, err := ioutil.ReadAll(resp.Body)
-	defer resp.Body.Close()
-	defer client.CloseIdleConnections()
-	if err != nil {
-		return userQuery.User
+	if len(client.opts.BaseURL) == 0 {
+		client.opts.BaseURL = slackAPIURL
 	}
-	if !strings.Contains(string(text), "\"ok\":true") {
-		return userQuery.User
+	if client.opts.MaxRetries == 0 {
+		client.opts.MaxRetries = slackMaxRetries
 	}
-	err = json.NewDecoder(strings.NewReader(string(text))).Decode(&userQuery)
-	if err != nil {
-		return userQuery.User
+	return client
+}
+
+// Call - Calls a Slack API method and decodes the response into out, out can be nil.
+// url.Values and map[string]string params are form encoded, any other params are sent as a JSON body
+func (c *SlackAPIClient) Call(ctx context.Context, method string, params interface{}, out interface{}) error {
+	req := c.request(ctx, method, nil)
+	switch p := params.(type) {
+	case nil:
+	case url.Values:
+		req.params = p
+	case map[string]string:
+		for k, v := range p {
+			req.params.Set(k, v)
+		}
+	default:
+		jsonParams, err := json.Marshal(p)
+		if err != nil {
+			return err
+		}
+		if !c.acceptsJSON(method) {
+			req.params, err = formParams(jsonParams)
+			if err != nil {
+				return err
+			}
+		} else {
+			req.body = jsonParams
+		}
 	}
-	return userQuery.User
+	return req.do(http.MethodPost, out)
 }
 
-// slackMessageCall - Calls Slack Chat.PostMessage API
-func slackMessageCall(uri string, form url.Values, token string) bool {
-	client := &http.Client{}
-	r, err := http.NewRequest("POST", uri, strings.NewReader(form.Encode()))
-	if err != nil {
-		return false
+// acceptsJSON - Whether method is sent JSON bodies
+func (c *SlackAPIClient) acceptsJSON(method string) bool {
+	for _, m := range c.opts.FormMethods {
+		if m == method {
+			return false
+		}
 	}
-	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
-	r.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
-	resp, err := client.Do(r)
+	return true
+}
+
+// formParams - Flatten a JSON object into form values, non string values are kept as JSON text
+func formParams(jsonParams []byte) (url.Values, error) {
+	var fields map[string]json.RawMessage
+	err := json.Unmarshal(jsonParams, &fields)
 	if err != nil {
-		return false
+		return nil, err
 	}
-	bodyText, err := ioutil.ReadAll(resp.Body)
-	defer resp.Body.Close()
-	defer client.CloseIdleConnections()
-	if err != nil {
-		return false
+	form := url.Values{}
+	for k, raw := range fields {
+		var text string
+		if string(raw) == "null" {
+			continue
+		} else if json.Unmarshal(raw, &text) == nil {
+			form.Set(k, text)
+		} else {
+			form.Set(k, string(raw))
+		}
 	}
-	if !strings.Contains(string(bodyText), "\"ok\":true") {
-		return false
+	return form, nil
+}
+
+// request - Return a request to method with params, params can be nil
+func (c *SlackAPIClient) request(ctx context.Context, method string, params url.Values) *slackRequest {
+	if params == nil {
+		params = url.Values{}
 	}
-	return true
+	return &slackRequest{
+		ctx:    ctx,
+		client: c,
+		method: method,
+		params: params}
+}
+
+// slackRequest - Slack API request builder shared by every API wrapper
+type slackRequest struct {
+	ctx    context.Context
+	client *SlackAPIClient // Client providing the token and options
+	method string          // Slack API method, e.g. users.info
+	params url.Values      // Escaped into the query string (GET) or the form body (POST)
+	body   []byte          // JSON body, sent instead of params when set
 }
 
-// OpenView - Open view in slack
-func OpenView(view SlackModal, triggerID string, token string) bool {
-	jsonView, err := json.Marshal(view)
+// build - Build the HTTP request
+func (s *slackRequest) build(httpMethod string) (*http.Request, error) {
+	uri, err := url.Parse(s.client.opts.BaseURL + s.method)
 	if err != nil {
-		return false
+		return nil, err
 	}
-	form := url.Values{}
-	form.Set("view", string(jsonView))
-	form.Set("trigger_id", triggerID)
-	client := &http.Client{}
-	r, err := http.NewRequest("POST", "https://slack.com/api/views.open", strings.NewReader(form.Encode()))
-	if err != nil {
-		return false
+	var body io.Reader
+	contentType := "application/x-www-form-urlencoded"
+	if httpMethod == http.MethodGet {
+		uri.RawQuery = s.params.Encode()
+	} else if s.body != nil {
+		body = bytes.NewReader(s.body)
+		contentType = "application/json; charset=utf-8"
+	} else {
+		body = strings.NewReader(s.params.Encode())
 	}
-	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
-	r.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
-	resp, err := client.Do(r)
+	r, err := http.NewRequestWithContext(s.ctx, httpMethod, uri.String(), body)
 	if err != nil {
-		return false
+		return nil, err
 	}
-	text, err := ioutil.ReadAll(resp.Body)
-	defer resp.Body.Close()
-	defer client.CloseIdleConnections()
-	if err != nil {
-		return false
+	if body != nil {
+		r.Header.Set("Content-Type", contentType)
 	}
-	if !strings.Contains(string(text), "\"ok\":true") {
-		fmt.Println(string(text))
-		return false
+	if len(s.client.token) > 0 {
+		r.Header.Set("Authorization", fmt.Sprintf("Bearer %s", s.client.token))
 	}
-	return true
+	return r, nil
 }
 
-// UpdateView - Update a view in slack
-func UpdateView(view SlackModal, viewID string, token string) bool {
-	jsonView, err := json.Marshal(view)
-	if err != nil {
-		log.Printf("Unable to get JSON from view")
-		return false
-	}
-	form := url.Values{}
-	form.Set("view", string(jsonView))
-	form.Set("view_id", viewID)
-	client := &http.Client{}
-	r, err := http.NewRequest("POST", "https://slack.com/api/views.update", strings.NewReader(form.Encode()))
+// do - Send the request and decode the response into out, out can be nil
+func (s *slackRequest) do(httpMethod string, out interface{}) error {
+	_, text, err := sendSlackRequest(s.ctx, &s.client.opts, func() (*http.Request, error) {
+		return s.build(httpMethod)
+	})
 	if err != nil {
-		return false
+		return err
 	}
-	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
-	r.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
-	resp, err := client.Do(r)
+	var status slackAPIResponse
+	err = json.Unmarshal(text, &status)
 	if err != nil {
-		return false
+		return err
 	}
-	text, err := ioutil.ReadAll(resp.Body)
-	defer resp.Body.Close()
-	defer client.CloseIdleConnections()
-	if err != nil {
-		return false
+	if !status.Ok {
+		return &SlackAPIError{Method: s.method, Code: status.Error}
 	}
-	if !strings.Contains(string(text), "\"ok\":true") {
-		return false
+	if out == nil {
+		return nil
 	}
-	return true
+	return json.Unmarshal(text, out)
 }
 
-// FindUserByEmail - Finding slack user by email
-func FindUserByEmail(email string, token string) SlackUser {
-	foundUser := slackUserCall(fmt.Sprintf("https://slack.com/api/users.lookupByEmail?email=%s&token=%s", email, token), token)
-	return foundUser
-}
-
-// FindUserByID - Finding slack user by id
-func FindUserByID(id string, token string) SlackUser {
-	foundUser := slackUserCall(fmt.Sprintf("https://slack.com/api/users.info?user=%s&token=%s", id, token), token)
-	return foundUser
+// retryAfter - Wait asked by a rate limited response
+func retryAfter(resp *http.Response) time.Duration {
+	seconds, err := strconv.Atoi(resp.Header.Get("Retry-After"))
+	if err != nil || seconds <= 0 {
+		return slackDefaultRetryAfter
+	}
+	return time.Duration(seconds) * time.Second
 }
 
-// UpdateMessage - Update a slack message
-func UpdateMessage(channel string, ts string, blocks ISlackBlockKitUI, text string, token string) bool {
-	var jsonBlocks []byte
-	var err error
-	if blocks != nil {
-		jsonBlocks, err = json.Marshal(blocks)
+// sendSlackRequest - Send the request made by build and return status and body, retrying while rate limited
+func sendSlackRequest(ctx context.Context, opts *SlackAPIClientOptions, build func() (*http.Request, error)) (int, []byte, error) {
+	client := opts.HTTPClient
+	if client == nil {
+		client = &http.Client{}
+		defer client.CloseIdleConnections()
+	}
+	for attempt := 0; ; attempt++ {
+		r, err := build()
 		if err != nil {
-			panic("Invalid JSON Block Object passed to UpdateMessage")
+			return 0, nil, err
 		}
-	}
-	form := url.Values{}
-	form.Set("channel", channel)
-	form.Set("ts", ts)
-	if blocks != nil {
-		form.Set("blocks", string(jsonBlocks))
-	}
-	form.Set("text", text)
-	res := slackMessageCall("https://slack.com/api/chat.update", form, token)
-	return res
-}
-
-// PostMessage - Post a message
-func PostMessage(channel string, blocks ISlackBlockKitUI, text string, token string) bool {
-	var jsonBlocks []byte
-	var err error
-	if blocks != nil {
-		jsonBlocks, err = json.Marshal(blocks)
+		resp, err := client.Do(r)
 		if err != nil {
-			panic("Invalid JSON Block Object passed to UpdateMessage")
+			return 0, nil, err
+		}
+		text, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return resp.StatusCode, nil, err
+		}
+		if resp.StatusCode != http.StatusTooManyRequests || attempt >= opts.MaxRetries {
+			return resp.StatusCode, text, nil
+		}
+		select {
+		case <-ctx.Done():
+			return 0, nil, ctx.Err()
+		case <-time.After(retryAfter(resp)):
 		}
 	}
-	form := url.Values{}
-	form.Set("channel", channel)
-	if blocks != nil {
-		form.Set("blocks", string(jsonBlocks))
-	}
-	form.Set("text", text)
-	res := slackMessageCall("https://slack.com/api/chat.postMessage", form, token)
-	return res
 }
 
-// FileUpload - Upload a file
-func FileUpload(channels []string, filename string, content string, filetype string, token string) error {
-	form := url.Values{}
-	form.Set("content", content)
-	form.Set("filename", filename)
-	form.Set("filetype", filetype)
-	form.Set("channels", strings.Join(channels, ","))
-	client := &http.Client{}
-	r, err := http.NewRequest("POST", "https://slack.com/api/files.upload", strings.NewReader(form.Encode()))
-	if err != nil {
-		return err
-	}
-	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
-	r.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
-	resp, err := client.Do(r)
-	if err != nil {
-		return err
-	}
-	text, err := ioutil.ReadAll(resp.Body)
-	defer resp.Body.Close()
-	defer client.CloseIdleConnections()
-	if err != nil {
-		return err
-	}
-	if !strings.Contains(string(text), "\"ok\":true") {
-		return err
-	}
-	return nil
+// slackAPICall - Calls a Slack API method with the default client options and decodes the response into out, out can be nil
+func slackAPICall(method string, params interface{}, token string, out interface{}) error {
+	return InitializeSlackAPIClient(token, nil).Call(context.Background(), method, params, out)
 }
@@ -0,0 +1,123 @@
+package loafer
+
+import (
+	"net/url"
+	"strconv"
+)
+
+// SlackAuthIdentity - Slack auth.test response
+type SlackAuthIdentity struct {
+	URL                 string `json:"url"`
+	Team                string `json:"team"`
+	User                string `json:"user"`
+	TeamID              string `json:"team_id"`
+	UserID              string `json:"user_id"`
+	BotID               string `json:"bot_id"`
+	EnterpriseID        string `json:"enterprise_id"`
+	IsEnterpriseInstall bool   `json:"is_enterprise_install"`
+}
+
+// SlackTeamIcon - Slack workspace icon
+type SlackTeamIcon struct {
+	Image34      string `json:"image_34"`
+	Image44      string `json:"image_44"`
+	Image68      string `json:"image_68"`
+	Image88      string `json:"image_88"`
+	Image102     string `json:"image_102"`
+	Image132     string `json:"image_132"`
+	Image230     string `json:"image_230"`
+	ImageDefault bool   `json:"image_default"`
+}
+
+// SlackTeam - Slack workspace
+type SlackTeam struct {
+	ID             string        `json:"id"`
+	Name           string        `json:"name"`
+	Domain         string        `json:"domain"`
+	EmailDomain    string        `json:"email_domain"`
+	Icon           SlackTeamIcon `json:"icon"`
+	EnterpriseID   string        `json:"enterprise_id"`
+	EnterpriseName string        `json:"enterprise_name"`
+}
+
+// SlackTeamProfileField - Slack workspace profile field
+type SlackTeamProfileField struct {
+	ID             string   `json:"id"`
+	Ordering       int      `json:"ordering"`
+	Label          string   `json:"label"`
+	Hint           string   `json:"hint"`
+	Type           string   `json:"type"`
+	PossibleValues []string `json:"possible_values"`
+	IsHidden       bool     `json:"is_hidden"`
+}
+
+// SlackBotIcons - Slack bot icons
+type SlackBotIcons struct {
+	Image36 string `json:"image_36"`
+	Image48 string `json:"image_48"`
+	Image72 string `json:"image_72"`
+}
+
+// SlackBot - Slack bot
+type SlackBot struct {
+	ID      string        `json:"id"`
+	Deleted bool          `json:"deleted"`
+	Name    string        `json:"name"`
+	Updated int64         `json:"updated"`
+	AppID   string        `json:"app_id"`
+	UserID  string        `json:"user_id"`
+	Icons   SlackBotIcons `json:"icons"`
+}
+
+// AuthTest - Check token and return who it belongs to
+func AuthTest(token string) (SlackAuthIdentity, error) {
+	var res SlackAuthIdentity
+	err := slackAPICall("auth.test", nil, token, &res)
+	return res, err
+}
+
+// RevokeAuth - Revoke token, test only checks whether it would be revoked
+func RevokeAuth(test bool, token string) (bool, error) {
+	var res struct {
+		Revoked bool `json:"revoked"`
+	}
+	form := url.Values{}
+	form.Set("test", strconv.FormatBool(test))
+	err := slackAPICall("auth.revoke", form, token, &res)
+	return res.Revoked, err
+}
+
+// GetTeamInfo - Get information about a workspace, team can be empty for the token's workspace
+func GetTeamInfo(team string, token string) (SlackTeam, error) {
+	var res struct {
+		Team SlackTeam `json:"team"`
+	}
+	form := url.Values{}
+	if len(team) > 0 {
+		form.Set("team", team)
+	}
+	err := slackAPICall("team.info", form, token, &res)
+	return res.Team, err
+}
+
+// GetTeamProfile - Get the profile fields of the token's workspace
+func GetTeamProfile(token string) ([]SlackTeamProfileField, error) {
+	var res struct {
+		Profile struct {
+			Fields []SlackTeamProfileField `json:"fields"`
+		} `json:"profile"`
+	}
+	err := slackAPICall("team.profile.get", nil, token, &res)
+	return res.Profile.Fields, err
+}
+
+// GetBotInfo - Get information about a bot
+func GetBotInfo(bot string, token string) (SlackBot, error) {
+	var res struct {
+		Bot SlackBot `json:"bot"`
+	}
+	form := url.Values{}
+	form.Set("bot", bot)
+	err := slackAPICall("bots.info", form, token, &res)
+	return res.Bot, err
+}
@@ -0,0 +1,535 @@
+package loafer
+
+import "encoding/json"
+
+// Block - Slack Block Kit block, only implemented by the loafer block structs
+type Block interface {
+	slackBlock()
+}
+
+// ActionsElement - Element of an actions block, a button, overflow menu, select or picker
+type ActionsElement interface {
+	slackActionsElement()
+}
+
+// InputElement - Element of an input block, a text, number, select, picker or file input
+type InputElement interface {
+	slackInputElement()
+}
+
+// AccessoryElement - Accessory of a section block, a button, overflow menu, select, picker or image
+type AccessoryElement interface {
+	slackAccessoryElement()
+}
+
+// ContextElement - Element of a context block, a text object or an image element
+type ContextElement interface {
+	slackContextElement()
+}
+
+// RichTextBlockElement - Element of a rich text block, a section, list, quote or preformatted
+type RichTextBlockElement interface {
+	slackRichTextBlockElement()
+}
+
+// Blocks - Blocks of a surface, decoded into the loafer block structs base on their type
+type Blocks []Block
+
+// ActionsElements - Elements of an actions block, decoded into the loafer element structs base on their type
+type ActionsElements []ActionsElement
+
+// ContextElements - Elements of a context block, decoded base on their type
+type ContextElements []ContextElement
+
+// RichTextBlockElements - Elements of a rich text block, decoded base on their type
+type RichTextBlockElements []RichTextBlockElement
+
+// UnknownBlock - Block of a type loafer does not know, kept as raw JSON so it is sent back unchanged
+type UnknownBlock struct {
+	Type string          // Type of the block
+	Raw  json.RawMessage // JSON of the block
+}
+
+// UnknownBlockElement - Element of a type loafer does not know, kept as raw JSON so it is sent back unchanged
+type UnknownBlockElement struct {
+	Type string          // Type of the element
+	Raw  json.RawMessage // JSON of the element
+}
+
+// MarshalJSON - Return the raw JSON of the block
+func (b UnknownBlock) MarshalJSON() ([]byte, error) {
+	return b.Raw, nil
+}
+
+// MarshalJSON - Return the raw JSON of the element
+func (e UnknownBlockElement) MarshalJSON() ([]byte, error) {
+	return e.Raw, nil
+}
+
+func (SlackSectionBlock) slackBlock()     {}
+func (SlackHeaderBlock) slackBlock()      {}
+func (SlackContextBlock) slackBlock()     {}
+func (SlackImageBlock) slackBlock()       {}
+func (SlackFileBlock) slackBlock()        {}
+func (SlackVideoBlock) slackBlock()       {}
+func (SlackMarkdownBlock) slackBlock()    {}
+func (SlackRichTextBlock) slackBlock()    {}
+func (SlackDivider) slackBlock()          {}
+func (SlackBlockActions) slackBlock()     {}
+func (SlackInputBlock) slackBlock()       {}
+func (SlackInputElement) slackBlock()     {}
+func (SlackModalSelect) slackBlock()      {}
+func (SlackBlockTextSection) slackBlock() {}
+func (SlackBlockTextFields) slackBlock()  {}
+func (SlackBlockUserSelect) slackBlock()  {}
+func (UnknownBlock) slackBlock()          {}
+
+func (SlackBlockButton) slackActionsElement()         {}
+func (SlackOverflowMenu) slackActionsElement()        {}
+func (SlackStaticSelect) slackActionsElement()        {}
+func (SlackUsersSelect) slackActionsElement()         {}
+func (SlackConversationsSelect) slackActionsElement() {}
+func (SlackExternalSelect) slackActionsElement()      {}
+func (SlackChannelSelect) slackActionsElement()       {}
+func (SlackDatePicker) slackActionsElement()          {}
+func (SlackTimePicker) slackActionsElement()          {}
+func (SlackDatetimePicker) slackActionsElement()      {}
+func (SlackCheckboxes) slackActionsElement()          {}
+func (SlackRadioButtons) slackActionsElement()        {}
+func (UnknownBlockElement) slackActionsElement()      {}
+
+func (SlackPlainTextInput) slackInputElement()      {}
+func (SlackStaticSelect) slackInputElement()        {}
+func (SlackUsersSelect) slackInputElement()         {}
+func (SlackConversationsSelect) slackInputElement() {}
+func (SlackExternalSelect) slackInputElement()      {}
+func (SlackChannelSelect) slackInputElement()       {}
+func (SlackDatePicker) slackInputElement()          {}
+func (SlackTimePicker) slackInputElement()          {}
+func (SlackDatetimePicker) slackInputElement()      {}
+func (SlackCheckboxes) slackInputElement()          {}
+func (SlackRadioButtons) slackInputElement()        {}
+func (SlackNumberInput) slackInputElement()         {}
+func (SlackEmailInput) slackInputElement()          {}
+func (SlackURLInput) slackInputElement()            {}
+func (SlackRichTextInput) slackInputElement()       {}
+func (SlackFileInput) slackInputElement()           {}
+func (UnknownBlockElement) slackInputElement()      {}
+
+func (SlackBlockButton) slackAccessoryElement()         {}
+func (SlackOverflowMenu) slackAccessoryElement()        {}
+func (SlackStaticSelect) slackAccessoryElement()        {}
+func (SlackUsersSelect) slackAccessoryElement()         {}
+func (SlackConversationsSelect) slackAccessoryElement() {}
+func (SlackExternalSelect) slackAccessoryElement()      {}
+func (SlackChannelSelect) slackAccessoryElement()       {}
+func (SlackDatePicker) slackAccessoryElement()          {}
+func (SlackTimePicker) slackAccessoryElement()          {}
+func (SlackCheckboxes) slackAccessoryElement()          {}
+func (SlackRadioButtons) slackAccessoryElement()        {}
+func (SlackImageElement) slackAccessoryElement()        {}
+func (UnknownBlockElement) slackAccessoryElement()      {}
+
+func (SlackBlockText) slackContextElement()      {}
+func (SlackImageElement) slackContextElement()   {}
+func (UnknownBlockElement) slackContextElement() {}
+
+func (SlackRichTextSection) slackRichTextBlockElement()      {}
+func (SlackRichTextList) slackRichTextBlockElement()         {}
+func (SlackRichTextQuote) slackRichTextBlockElement()        {}
+func (SlackRichTextPreformatted) slackRichTextBlockElement() {}
+func (UnknownBlockElement) slackRichTextBlockElement()       {}
+
+// blockTypes - Decoders of the block types loafer knows
+var blockTypes = map[string]func(data []byte) (Block, error){
+	"actions": func(data []byte) (Block, error) {
+		var b SlackBlockActions
+		return b, json.Unmarshal(data, &b)
+	},
+	"context": func(data []byte) (Block, error) {
+		var b SlackContextBlock
+		return b, json.Unmarshal(data, &b)
+	},
+	"divider": func(data []byte) (Block, error) {
+		var b SlackDivider
+		return b, json.Unmarshal(data, &b)
+	},
+	"file": func(data []byte) (Block, error) {
+		var b SlackFileBlock
+		return b, json.Unmarshal(data, &b)
+	},
+	"header": func(data []byte) (Block, error) {
+		var b SlackHeaderBlock
+		return b, json.Unmarshal(data, &b)
+	},
+	"image": func(data []byte) (Block, error) {
+		var b SlackImageBlock
+		return b, json.Unmarshal(data, &b)
+	},
+	"input": func(data []byte) (Block, error) {
+		var b SlackInputBlock
+		return b, json.Unmarshal(data, &b)
+	},
+	"markdown": func(data []byte) (Block, error) {
+		var b SlackMarkdownBlock
+		return b, json.Unmarshal(data, &b)
+	},
+	"rich_text": func(data []byte) (Block, error) {
+		var b SlackRichTextBlock
+		return b, json.Unmarshal(data, &b)
+	},
+	"section": func(data []byte) (Block, error) {
+		var b SlackSectionBlock
+		return b, json.Unmarshal(data, &b)
+	},
+	"video": func(data []byte) (Block, error) {
+		var b SlackVideoBlock
+		return b, json.Unmarshal(data, &b)
+	},
+}
+
+// elementTypes - Decoders of the element types loafer knows, checked against the container with fits
+var elementTypes = map[string]func(data []byte) (interface{}, error){
+	"button": func(data []byte) (interface{}, error) {
+		var e SlackBlockButton
+		return e, json.Unmarshal(data, &e)
+	},
+	"channels_select": func(data []byte) (interface{}, error) {
+		var e SlackChannelSelect
+		return e, json.Unmarshal(data, &e)
+	},
+	"checkboxes": func(data []byte) (interface{}, error) {
+		var e SlackCheckboxes
+		return e, json.Unmarshal(data, &e)
+	},
+	"conversations_select": func(data []byte) (interface{}, error) {
+		var e SlackConversationsSelect
+		return e, json.Unmarshal(data, &e)
+	},
+	"datepicker": func(data []byte) (interface{}, error) {
+		var e SlackDatePicker
+		return e, json.Unmarshal(data, &e)
+	},
+	"datetimepicker": func(data []byte) (interface{}, error) {
+		var e SlackDatetimePicker
+		return e, json.Unmarshal(data, &e)
+	},
+	"email_text_input": func(data []byte) (interface{}, error) {
+		var e SlackEmailInput
+		return e, json.Unmarshal(data, &e)
+	},
+	"external_select": func(data []byte) (interface{}, error) {
+		var e SlackExternalSelect
+		return e, json.Unmarshal(data, &e)
+	},
+	"file_input": func(data []byte) (interface{}, error) {
+		var e SlackFileInput
+		return e, json.Unmarshal(data, &e)
+	},
+	"image": func(data []byte) (interface{}, error) {
+		var e SlackImageElement
+		return e, json.Unmarshal(data, &e)
+	},
+	"multi_channels_select": func(data []byte) (interface{}, error) {
+		var e SlackChannelSelect
+		return e, json.Unmarshal(data, &e)
+	},
+	"multi_conversations_select": func(data []byte) (interface{}, error) {
+		var e SlackConversationsSelect
+		return e, json.Unmarshal(data, &e)
+	},
+	"multi_external_select": func(data []byte) (interface{}, error) {
+		var e SlackExternalSelect
+		return e, json.Unmarshal(data, &e)
+	},
+	"multi_static_select": func(data []byte) (interface{}, error) {
+		var e SlackStaticSelect
+		return e, json.Unmarshal(data, &e)
+	},
+	"multi_users_select": func(data []byte) (interface{}, error) {
+		var e SlackUsersSelect
+		return e, json.Unmarshal(data, &e)
+	},
+	"number_input": func(data []byte) (interface{}, error) {
+		var e SlackNumberInput
+		return e, json.Unmarshal(data, &e)
+	},
+	"overflow": func(data []byte) (interface{}, error) {
+		var e SlackOverflowMenu
+		return e, json.Unmarshal(data, &e)
+	},
+	"plain_text_input": func(data []byte) (interface{}, error) {
+		var e SlackPlainTextInput
+		return e, json.Unmarshal(data, &e)
+	},
+	"radio_buttons": func(data []byte) (interface{}, error) {
+		var e SlackRadioButtons
+		return e, json.Unmarshal(data, &e)
+	},
+	"rich_text_input": func(data []byte) (interface{}, error) {
+		var e SlackRichTextInput
+		return e, json.Unmarshal(data, &e)
+	},
+	"static_select": func(data []byte) (interface{}, error) {
+		var e SlackStaticSelect
+		return e, json.Unmarshal(data, &e)
+	},
+	"timepicker": func(data []byte) (interface{}, error) {
+		var e SlackTimePicker
+		return e, json.Unmarshal(data, &e)
+	},
+	"url_text_input": func(data []byte) (interface{}, error) {
+		var e SlackURLInput
+		return e, json.Unmarshal(data, &e)
+	},
+	"users_select": func(data []byte) (interface{}, error) {
+		var e SlackUsersSelect
+		return e, json.Unmarshal(data, &e)
+	},
+}
+
+// contextElementTypes - Decoders of the context element types loafer knows
+var contextElementTypes = map[string]func(data []byte) (ContextElement, error){
+	"plain_text": func(data []byte) (ContextElement, error) {
+		var e SlackBlockText
+		return e, json.Unmarshal(data, &e)
+	},
+	"mrkdwn": func(data []byte) (ContextElement, error) {
+		var e SlackBlockText
+		return e, json.Unmarshal(data, &e)
+	},
+	"image": func(data []byte) (ContextElement, error) {
+		var e SlackImageElement
+		return e, json.Unmarshal(data, &e)
+	},
+}
+
+// richTextBlockElementTypes - Decoders of the rich text block element types loafer knows
+var richTextBlockElementTypes = map[string]func(data []byte) (RichTextBlockElement, error){
+	"rich_text_section": func(data []byte) (RichTextBlockElement, error) {
+		var e SlackRichTextSection
+		return e, json.Unmarshal(data, &e)
+	},
+	"rich_text_list": func(data []byte) (RichTextBlockElement, error) {
+		var e SlackRichTextList
+		return e, json.Unmarshal(data, &e)
+	},
+	"rich_text_quote": func(data []byte) (RichTextBlockElement, error) {
+		var e SlackRichTextQuote
+		return e, json.Unmarshal(data, &e)
+	},
+	"rich_text_preformatted": func(data []byte) (RichTextBlockElement, error) {
+		var e SlackRichTextPreformatted
+		return e, json.Unmarshal(data, &e)
+	},
+}
+
+// splitTyped - Split a JSON array into its items and their type
+func splitTyped(data []byte) ([]json.RawMessage, []string, error) {
+	var items []json.RawMessage
+	err := json.Unmarshal(data, &items)
+	if err != nil {
+		return nil, nil, err
+	}
+	types := make([]string, len(items))
+	for i, item := range items {
+		types[i], err = typeOf(item)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return items, types, nil
+}
+
+// typeOf - Type of a JSON block or element
+func typeOf(data []byte) (string, error) {
+	var typed struct {
+		Type string `json:"type"`
+	}
+	err := json.Unmarshal(data, &typed)
+	return typed.Type, err
+}
+
+// decodeElement - Decode a single element, nil for a JSON null.
+// Elements the container does not allow, fits returning false, are kept as UnknownBlockElement
+func decodeElement(data json.RawMessage, fits func(element interface{}) bool) (interface{}, error) {
+	if len(data) == 0 || string(data) == "null" {
+		return nil, nil
+	}
+	elementType, err := typeOf(data)
+	if err != nil {
+		return nil, err
+	}
+	if decode, ok := elementTypes[elementType]; ok {
+		element, err := decode(data)
+		if err != nil {
+			return nil, err
+		}
+		if fits(element) {
+			return element, nil
+		}
+	}
+	return UnknownBlockElement{Type: elementType, Raw: data}, nil
+}
+
+// unknownElement - Keep an element the container cannot hold as raw JSON
+func unknownElement(data json.RawMessage) UnknownBlockElement {
+	elementType, _ := typeOf(data)
+	return UnknownBlockElement{Type: elementType, Raw: data}
+}
+
+// isActionsElement - Whether element is allowed in an actions block
+func isActionsElement(element interface{}) bool {
+	_, ok := element.(ActionsElement)
+	return ok
+}
+
+// isInputElement - Whether element is allowed in an input block
+func isInputElement(element interface{}) bool {
+	_, ok := element.(InputElement)
+	return ok
+}
+
+// isAccessoryElement - Whether element is allowed as a section accessory
+func isAccessoryElement(element interface{}) bool {
+	_, ok := element.(AccessoryElement)
+	return ok
+}
+
+// UnmarshalJSON - Decode each block into its loafer struct, or UnknownBlock
+func (b *Blocks) UnmarshalJSON(data []byte) error {
+	items, types, err := splitTyped(data)
+	if err != nil || items == nil {
+		*b = nil
+		return err
+	}
+	blocks := make(Blocks, len(items))
+	for i, item := range items {
+		if decode, ok := blockTypes[types[i]]; ok {
+			blocks[i], err = decode(item)
+			if err != nil {
+				return err
+			}
+		} else {
+			blocks[i] = UnknownBlock{Type: types[i], Raw: item}
+		}
+	}
+	*b = blocks
+	return nil
+}
+
+// UnmarshalJSON - Decode each element into its loafer struct, or UnknownBlockElement
+func (e *ActionsElements) UnmarshalJSON(data []byte) error {
+	var items []json.RawMessage
+	err := json.Unmarshal(data, &items)
+	if err != nil || items == nil {
+		*e = nil
+		return err
+	}
+	elements := make(ActionsElements, len(items))
+	for i, item := range items {
+		element, err := decodeElement(item, isActionsElement)
+		if err != nil {
+			return err
+		}
+		if actionsElement, ok := element.(ActionsElement); ok {
+			elements[i] = actionsElement
+		} else if element != nil {
+			elements[i] = unknownElement(item)
+		}
+	}
+	*e = elements
+	return nil
+}
+
+// UnmarshalJSON - Decode each element into a text object or image element, or UnknownBlockElement
+func (e *ContextElements) UnmarshalJSON(data []byte) error {
+	items, types, err := splitTyped(data)
+	if err != nil || items == nil {
+		*e = nil
+		return err
+	}
+	elements := make(ContextElements, len(items))
+	for i, item := range items {
+		if decode, ok := contextElementTypes[types[i]]; ok {
+			elements[i], err = decode(item)
+			if err != nil {
+				return err
+			}
+		} else {
+			elements[i] = UnknownBlockElement{Type: types[i], Raw: item}
+		}
+	}
+	*e = elements
+	return nil
+}
+
+// UnmarshalJSON - Decode each element into its rich text struct, or UnknownBlockElement
+func (e *RichTextBlockElements) UnmarshalJSON(data []byte) error {
+	items, types, err := splitTyped(data)
+	if err != nil || items == nil {
+		*e = nil
+		return err
+	}
+	elements := make(RichTextBlockElements, len(items))
+	for i, item := range items {
+		if decode, ok := richTextBlockElementTypes[types[i]]; ok {
+			elements[i], err = decode(item)
+			if err != nil {
+				return err
+			}
+		} else {
+			elements[i] = UnknownBlockElement{Type: types[i], Raw: item}
+		}
+	}
+	*e = elements
+	return nil
+}
+
+// UnmarshalJSON - Decode the section, with its accessory as a loafer element
+func (s *SlackSectionBlock) UnmarshalJSON(data []byte) error {
+	type section SlackSectionBlock
+	var raw struct {
+		section
+		Accessory json.RawMessage `json:"accessory"`
+	}
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return err
+	}
+	*s = SlackSectionBlock(raw.section)
+	element, err := decodeElement(raw.Accessory, isAccessoryElement)
+	if err != nil {
+		return err
+	}
+	if accessory, ok := element.(AccessoryElement); ok {
+		s.Accessory = accessory
+	} else if element != nil {
+		s.Accessory = unknownElement(raw.Accessory)
+	}
+	return nil
+}
+
+// UnmarshalJSON - Decode the input block, with its element as a loafer element
+func (b *SlackInputBlock) UnmarshalJSON(data []byte) error {
+	type input SlackInputBlock
+	var raw struct {
+		input
+		Element json.RawMessage `json:"element"`
+	}
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return err
+	}
+	*b = SlackInputBlock(raw.input)
+	element, err := decodeElement(raw.Element, isInputElement)
+	if err != nil {
+		return err
+	}
+	if inputElement, ok := element.(InputElement); ok {
+		b.Element = inputElement
+	} else if element != nil {
+		b.Element = unknownElement(raw.Element)
+	}
+	return nil
+}
@@ -0,0 +1,248 @@
+package loafer
+
+// SlackSectionBlock - Slack section block, with text and/or fields and an optional accessory element
+type SlackSectionBlock struct {
+	Type      string           `json:"type,omitempty"`
+	BlockID   string           `json:"block_id,omitempty"`
+	Text      *SlackBlockText  `json:"text,omitempty"`
+	Fields    []SlackBlockText `json:"fields,omitempty"`
+	Accessory AccessoryElement `json:"accessory,omitempty"`
+}
+
+// SlackHeaderBlock - Slack header block, text must be plain_text
+type SlackHeaderBlock struct {
+	Type    string          `json:"type,omitempty"`
+	BlockID string          `json:"block_id,omitempty"`
+	Text    *SlackBlockText `json:"text,omitempty"`
+}
+
+// SlackContextBlock - Slack context block, elements are text objects and image elements
+type SlackContextBlock struct {
+	Type     string          `json:"type,omitempty"`
+	BlockID  string          `json:"block_id,omitempty"`
+	Elements ContextElements `json:"elements"`
+}
+
+// SlackImageBlock - Slack image block, title must be plain_text
+type SlackImageBlock struct {
+	Type     string          `json:"type,omitempty"`
+	BlockID  string          `json:"block_id,omitempty"`
+	ImageURL string          `json:"image_url,omitempty"`
+	AltText  string          `json:"alt_text,omitempty"`
+	Title    *SlackBlockText `json:"title,omitempty"`
+}
+
+// SlackFileBlock - Slack file block showing a remote file
+type SlackFileBlock struct {
+	Type       string `json:"type,omitempty"`
+	BlockID    string `json:"block_id,omitempty"`
+	ExternalID string `json:"external_id,omitempty"`
+	Source     string `json:"source,omitempty"`
+}
+
+// SlackVideoBlock - Slack video block, title must be plain_text
+type SlackVideoBlock struct {
+	Type            string          `json:"type,omitempty"`
+	BlockID         string          `json:"block_id,omitempty"`
+	AltText         string          `json:"alt_text,omitempty"`
+	Title           *SlackBlockText `json:"title,omitempty"`
+	TitleURL        string          `json:"title_url,omitempty"`
+	Description     *SlackBlockText `json:"description,omitempty"`
+	VideoURL        string          `json:"video_url,omitempty"`
+	ThumbnailURL    string          `json:"thumbnail_url,omitempty"`
+	AuthorName      string          `json:"author_name,omitempty"`
+	ProviderName    string          `json:"provider_name,omitempty"`
+	ProviderIconURL string          `json:"provider_icon_url,omitempty"`
+}
+
+// SlackMarkdownBlock - Slack markdown block, text is standard markdown
+type SlackMarkdownBlock struct {
+	Type    string `json:"type,omitempty"`
+	BlockID string `json:"block_id,omitempty"`
+	Text    string `json:"text,omitempty"`
+}
+
+// SlackRichTextBlock - Slack rich text block, elements are rich text sections, lists, quotes and preformatted
+type SlackRichTextBlock struct {
+	Type     string                `json:"type,omitempty"`
+	BlockID  string                `json:"block_id,omitempty"`
+	Elements RichTextBlockElements `json:"elements"`
+}
+
+// SlackRichTextStyle - Slack rich text element style
+type SlackRichTextStyle struct {
+	Bold      bool `json:"bold,omitempty"`
+	Italic    bool `json:"italic,omitempty"`
+	Strike    bool `json:"strike,omitempty"`
+	Code      bool `json:"code,omitempty"`
+	Underline bool `json:"underline,omitempty"`
+}
+
+// SlackRichTextElement - Slack rich text element (text, link, user, usergroup, channel, emoji, broadcast, date or color)
+type SlackRichTextElement struct {
+	Type        string              `json:"type,omitempty"`
+	Text        string              `json:"text,omitempty"`
+	URL         string              `json:"url,omitempty"`
+	UserID      string              `json:"user_id,omitempty"`
+	UsergroupID string              `json:"usergroup_id,omitempty"`
+	ChannelID   string              `json:"channel_id,omitempty"`
+	Name        string              `json:"name,omitempty"`
+	Unicode     string              `json:"unicode,omitempty"`
+	SkinTone    uint8               `json:"skin_tone,omitempty"`
+	Range       string              `json:"range,omitempty"`
+	Timestamp   int64               `json:"timestamp,omitempty"`
+	Format      string              `json:"format,omitempty"`
+	Fallback    string              `json:"fallback,omitempty"`
+	Value       string              `json:"value,omitempty"`
+	Unsafe      bool                `json:"unsafe,omitempty"`
+	Style       *SlackRichTextStyle `json:"style,omitempty"`
+}
+
+// SlackRichTextSection - Slack rich text section
+type SlackRichTextSection struct {
+	Type     string                 `json:"type,omitempty"`
+	Elements []SlackRichTextElement `json:"elements"`
+}
+
+// SlackRichTextList - Slack rich text list, style is bullet or ordered
+type SlackRichTextList struct {
+	Type     string                 `json:"type,omitempty"`
+	Style    string                 `json:"style,omitempty"`
+	Indent   uint8                  `json:"indent,omitempty"`
+	Offset   uint16                 `json:"offset,omitempty"`
+	Border   uint8                  `json:"border,omitempty"`
+	Elements []SlackRichTextSection `json:"elements"`
+}
+
+// SlackRichTextQuote - Slack rich text quote
+type SlackRichTextQuote struct {
+	Type     string                 `json:"type,omitempty"`
+	Border   uint8                  `json:"border,omitempty"`
+	Elements []SlackRichTextElement `json:"elements"`
+}
+
+// SlackRichTextPreformatted - Slack rich text code block
+type SlackRichTextPreformatted struct {
+	Type     string                 `json:"type,omitempty"`
+	Border   uint8                  `json:"border,omitempty"`
+	Elements []SlackRichTextElement `json:"elements"`
+}
+
+// MakeSlackSection - Make a section block with markdown text, accessory can be nil
+func MakeSlackSection(text string, accessory AccessoryElement) SlackSectionBlock {
+	return SlackSectionBlock{
+		Type: "section",
+		Text: &SlackBlockText{
+			Type: "mrkdwn",
+			Text: text},
+		Accessory: accessory}
+}
+
+// MakeSlackMarkdownContext - Make a slack context (markdown)
+func MakeSlackMarkdownContext(text string) SlackContextBlock {
+	return SlackContextBlock{
+		Type: "context",
+		Elements: []ContextElement{
+			SlackBlockText{
+				Type: "mrkdwn",
+				Text: text}}}
+}
+
+// MakeSlackFile - Make a slack file block of a remote file
+func MakeSlackFile(externalID string) SlackFileBlock {
+	return SlackFileBlock{
+		Type:       "file",
+		ExternalID: externalID,
+		Source:     "remote"}
+}
+
+// MakeSlackVideo - Make a slack video block, the video URL must be embeddable
+func MakeSlackVideo(title string, videoURL string, thumbnailURL string, altText string) SlackVideoBlock {
+	return SlackVideoBlock{
+		Type:         "video",
+		Title:        makePlainText(title),
+		VideoURL:     videoURL,
+		ThumbnailURL: thumbnailURL,
+		AltText:      altText}
+}
+
+// MakeSlackMarkdown - Make a slack markdown block
+func MakeSlackMarkdown(text string) SlackMarkdownBlock {
+	return SlackMarkdownBlock{
+		Type: "markdown",
+		Text: text}
+}
+
+// MakeSlackRichText - Make a slack rich text block
+func MakeSlackRichText(elements ...RichTextBlockElement) SlackRichTextBlock {
+	return SlackRichTextBlock{
+		Type:     "rich_text",
+		Elements: elements}
+}
+
+// MakeSlackRichTextSection - Make a slack rich text section
+func MakeSlackRichTextSection(elements ...SlackRichTextElement) SlackRichTextSection {
+	return SlackRichTextSection{
+		Type:     "rich_text_section",
+		Elements: elements}
+}
+
+// MakeSlackRichTextList - Make a slack rich text list, style is bullet or ordered
+func MakeSlackRichTextList(style string, indent uint8, items ...SlackRichTextSection) SlackRichTextList {
+	return SlackRichTextList{
+		Type:     "rich_text_list",
+		Style:    style,
+		Indent:   indent,
+		Elements: items}
+}
+
+// MakeSlackRichTextQuote - Make a slack rich text quote
+func MakeSlackRichTextQuote(elements ...SlackRichTextElement) SlackRichTextQuote {
+	return SlackRichTextQuote{
+		Type:     "rich_text_quote",
+		Elements: elements}
+}
+
+// MakeSlackRichTextPreformatted - Make a slack rich text code block
+func MakeSlackRichTextPreformatted(elements ...SlackRichTextElement) SlackRichTextPreformatted {
+	return SlackRichTextPreformatted{
+		Type:     "rich_text_preformatted",
+		Elements: elements}
+}
+
+// MakeSlackRichTextString - Make a rich text text element, style can be nil
+func MakeSlackRichTextString(text string, style *SlackRichTextStyle) SlackRichTextElement {
+	return SlackRichTextElement{
+		Type:  "text",
+		Text:  text,
+		Style: style}
+}
+
+// MakeSlackRichTextLink - Make a rich text link element, text can be empty to show the URL
+func MakeSlackRichTextLink(url string, text string) SlackRichTextElement {
+	return SlackRichTextElement{
+		Type: "link",
+		URL:  url,
+		Text: text}
+}
+
+// MakeSlackRichTextUser - Make a rich text user mention
+func MakeSlackRichTextUser(userID string) SlackRichTextElement {
+	return SlackRichTextElement{
+		Type:   "user",
+		UserID: userID}
+}
+
+// MakeSlackRichTextChannel - Make a rich text channel mention
+func MakeSlackRichTextChannel(channelID string) SlackRichTextElement {
+	return SlackRichTextElement{
+		Type:      "channel",
+		ChannelID: channelID}
+}
+
+// MakeSlackRichTextEmoji - Make a rich text emoji, name without colons
+func MakeSlackRichTextEmoji(name string) SlackRichTextElement {
+	return SlackRichTextElement{
+		Type: "emoji",
+		Name: name}
+}
@@ -0,0 +1,83 @@
+package loafer
+
+import (
+	"net/url"
+)
+
+// SlackBookmark - Slack channel bookmark
+type SlackBookmark struct {
+	ID                  string `json:"id"`
+	ChannelID           string `json:"channel_id"`
+	Title               string `json:"title"`
+	Link                string `json:"link"`
+	Emoji               string `json:"emoji"`
+	IconURL             string `json:"icon_url"`
+	Type                string `json:"type"`
+	EntityID            string `json:"entity_id"`
+	DateCreated         int64  `json:"date_created"`
+	DateUpdated         int64  `json:"date_updated"`
+	Rank                string `json:"rank"`
+	LastUpdatedByUserID string `json:"last_updated_by_user_id"`
+	LastUpdatedByTeamID string `json:"last_updated_by_team_id"`
+	ShortcutID          string `json:"shortcut_id"`
+	AppID               string `json:"app_id"`
+}
+
+// slackBookmarkCall - Calls a bookmarks.* API that responds with a bookmark
+func slackBookmarkCall(method string, form url.Values, token string) (SlackBookmark, error) {
+	var res struct {
+		Bookmark SlackBookmark `json:"bookmark"`
+	}
+	err := slackAPICall(method, form, token, &res)
+	return res.Bookmark, err
+}
+
+// setBookmark - Set the bookmark fields onto form, empty fields are not sent
+func setBookmark(form url.Values, title string, link string, emoji string) {
+	if len(title) > 0 {
+		form.Set("title", title)
+	}
+	if len(link) > 0 {
+		form.Set("link", link)
+	}
+	if len(emoji) > 0 {
+		form.Set("emoji", emoji)
+	}
+}
+
+// AddBookmark - Add a link bookmark to a channel, emoji can be empty
+func AddBookmark(channel string, title string, link string, emoji string, token string) (SlackBookmark, error) {
+	form := url.Values{}
+	form.Set("channel_id", channel)
+	form.Set("type", "link")
+	setBookmark(form, title, link, emoji)
+	return slackBookmarkCall("bookmarks.add", form, token)
+}
+
+// EditBookmark - Edit a bookmark, empty fields are left unchanged
+func EditBookmark(channel string, bookmarkID string, title string, link string, emoji string, token string) (SlackBookmark, error) {
+	form := url.Values{}
+	form.Set("channel_id", channel)
+	form.Set("bookmark_id", bookmarkID)
+	setBookmark(form, title, link, emoji)
+	return slackBookmarkCall("bookmarks.edit", form, token)
+}
+
+// RemoveBookmark - Remove a bookmark from a channel
+func RemoveBookmark(channel string, bookmarkID string, token string) error {
+	form := url.Values{}
+	form.Set("channel_id", channel)
+	form.Set("bookmark_id", bookmarkID)
+	return slackAPICall("bookmarks.remove", form, token, nil)
+}
+
+// ListBookmarks - List the bookmarks of a channel
+func ListBookmarks(channel string, token string) ([]SlackBookmark, error) {
+	var res struct {
+		Bookmarks []SlackBookmark `json:"bookmarks"`
+	}
+	form := url.Values{}
+	form.Set("channel_id", channel)
+	err := slackAPICall("bookmarks.list", form, token, &res)
+	return res.Bookmarks, err
+}
@@ -0,0 +1,147 @@
+package loafer
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	broadcastConcurrency = 4           // Default number of messages sent at once
+	broadcastInterval    = time.Second // Default minimum time between two calls to the same target
+)
+
+// SlackBroadcastOptions - Broadcast fan out options
+type SlackBroadcastOptions struct {
+	Concurrency int             // Messages sent at once, defaults to 4
+	Interval    time.Duration   // Minimum time between two calls to the same target, defaults to 1s
+	Message     *MessageOptions // Options of every posted message
+}
+
+// SlackBroadcastResult - Outcome of a broadcast call for one target
+type SlackBroadcastResult struct {
+	Target  string // Channel or user id given to Broadcast
+	Channel string // Channel the message lives in, the DM channel for user targets
+	TS      string // ts of the message, empty if it was not posted or was deleted
+	Err     error  // Error of the last call, nil on success
+}
+
+// SlackBroadcast - Message posted to many targets
+type SlackBroadcast struct {
+	Results []SlackBroadcastResult // Outcome of the last call to every target
+	opts    SlackBroadcastOptions
+	pacer   *channelPacer
+}
+
+// channelPacer - Spaces out calls to the same target
+type channelPacer struct {
+	mutex    sync.Mutex
+	interval time.Duration
+	next     map[string]time.Time
+}
+
+// wait - Block until target may be called again
+func (p *channelPacer) wait(target string) {
+	p.mutex.Lock()
+	now := time.Now()
+	at := p.next[target]
+	if at.Before(now) {
+		at = now
+	}
+	p.next[target] = at.Add(p.interval)
+	p.mutex.Unlock()
+	time.Sleep(at.Sub(now))
+}
+
+// fanOut - Run call for every item, at most Concurrency at once and paced per target, so a user and its DM channel share a pace
+func (b *SlackBroadcast) fanOut(items []SlackBroadcastResult, call func(item SlackBroadcastResult) SlackBroadcastResult) []SlackBroadcastResult {
+	results := make([]SlackBroadcastResult, len(items))
+	slots := make(chan struct{}, b.opts.Concurrency)
+	var wg sync.WaitGroup
+	for i := range items {
+		wg.Add(1)
+		slots <- struct{}{}
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-slots }()
+			b.pacer.wait(items[i].Target)
+			results[i] = call(items[i])
+		}(i)
+	}
+	wg.Wait()
+	return results
+}
+
+// sent - Indexes in Results of the messages that were posted and not deleted
+func (b *SlackBroadcast) sent() []int {
+	indexes := []int{}
+	for i, r := range b.Results {
+		if len(r.TS) > 0 {
+			indexes = append(indexes, i)
+		}
+	}
+	return indexes
+}
+
+// fanOutSent - Run call for every posted message and write the results back into Results
+func (b *SlackBroadcast) fanOutSent(call func(item SlackBroadcastResult) SlackBroadcastResult) []SlackBroadcastResult {
+	indexes := b.sent()
+	items := make([]SlackBroadcastResult, len(indexes))
+	for i, index := range indexes {
+		items[i] = b.Results[index]
+	}
+	results := b.fanOut(items, call)
+	for i, index := range indexes {
+		b.Results[index] = results[i]
+	}
+	return results
+}
+
+// Broadcast - Post the same message to every target (channel or user id), opts can be nil
+func Broadcast(targets []string, blocks []Block, text string, opts *SlackBroadcastOptions, token string) *SlackBroadcast {
+	b := &SlackBroadcast{}
+	if opts != nil {
+		b.opts = *opts
+	}
+	if b.opts.Concurrency <= 0 {
+		b.opts.Concurrency = broadcastConcurrency
+	}
+	if b.opts.Interval <= 0 {
+		b.opts.Interval = broadcastInterval
+	}
+	b.pacer = &channelPacer{interval: b.opts.Interval, next: make(map[string]time.Time)}
+	items := []SlackBroadcastResult{}
+	for _, t := range targets {
+		items = append(items, SlackBroadcastResult{Target: t})
+	}
+	b.Results = b.fanOut(items, func(item SlackBroadcastResult) SlackBroadcastResult {
+		posted, err := PostMessage(item.Target, blocks, text, b.opts.Message, token)
+		item.Channel = posted.Channel
+		item.TS = posted.TS
+		item.Err = err
+		return item
+	})
+	return b
+}
+
+// Update - Update every posted message of the broadcast with the broadcast's message options
+func (b *SlackBroadcast) Update(blocks []Block, text string, token string) []SlackBroadcastResult {
+	return b.fanOutSent(func(item SlackBroadcastResult) SlackBroadcastResult {
+		updated, err := UpdateMessage(item.Channel, item.TS, blocks, text, b.opts.Message, token)
+		if err == nil && len(updated.TS) > 0 {
+			item.TS = updated.TS
+		}
+		item.Err = err
+		return item
+	})
+}
+
+// Delete - Delete every posted message of the broadcast, a deleted message has an empty TS
+func (b *SlackBroadcast) Delete(token string) []SlackBroadcastResult {
+	return b.fanOutSent(func(item SlackBroadcastResult) SlackBroadcastResult {
+		_, item.Err = DeleteMessage(item.Channel, item.TS, token)
+		if item.Err == nil {
+			item.TS = ""
+		}
+		return item
+	})
+}
@@ -0,0 +1,233 @@
+package loafer
+
+import (
+	"net/url"
+	"time"
+)
+
+// SlackMessageEdited - Slack message edit marker
+type SlackMessageEdited struct {
+	User string `json:"user,omitempty"`
+	TS   string `json:"ts,omitempty"`
+}
+
+// SlackMessageMetadata - Slack message metadata
+type SlackMessageMetadata struct {
+	EventType    string                 `json:"event_type"`
+	EventPayload map[string]interface{} `json:"event_payload"`
+}
+
+// SlackReaction - Slack emoji reaction on an item
+type SlackReaction struct {
+	Name  string   `json:"name"`
+	Count int      `json:"count"`
+	Users []string `json:"users"`
+}
+
+// SlackMessage - Slack message
+type SlackMessage struct {
+	Type            string                `json:"type,omitempty"`
+	SubType         string                `json:"subtype,omitempty"`
+	User            string                `json:"user,omitempty"`
+	BotID           string                `json:"bot_id,omitempty"`
+	AppID           string                `json:"app_id,omitempty"`
+	Team            string                `json:"team,omitempty"`
+	Text            string                `json:"text,omitempty"`
+	TS              string                `json:"ts,omitempty"`
+	ThreadTS        string                `json:"thread_ts,omitempty"`
+	ParentUserID    string                `json:"parent_user_id,omitempty"`
+	ReplyCount      int                   `json:"reply_count,omitempty"`
+	ReplyUsersCount int                   `json:"reply_users_count,omitempty"`
+	ReplyUsers      []string              `json:"reply_users,omitempty"`
+	LatestReply     string                `json:"latest_reply,omitempty"`
+	Edited          *SlackMessageEdited   `json:"edited,omitempty"`
+	Reactions       []SlackReaction       `json:"reactions,omitempty"`
+	Metadata        *SlackMessageMetadata `json:"metadata,omitempty"`
+	Blocks          Blocks                `json:"blocks,omitempty"`
+}
+
+// MessageOptions - Optional arguments of chat.* calls, fields left empty are not sent
+type MessageOptions struct {
+	ThreadTS       string                // Reply in the thread of this message ts
+	ReplyBroadcast bool                  // Also show a thread reply in the channel
+	UnfurlLinks    *bool                 // Unfurl text-based content
+	UnfurlMedia    *bool                 // Unfurl media content
+	Mrkdwn         *bool                 // Parse text as mrkdwn
+	Username       string                // Bot username override
+	IconEmoji      string                // Bot icon emoji override
+	IconURL        string                // Bot icon URL override
+	Metadata       *SlackMessageMetadata // Message metadata
+}
+
+// SlackChatResponse - Slack chat.* response for a posted or updated message
+type SlackChatResponse struct {
+	Channel string       `json:"channel"`
+	TS      string       `json:"ts"`
+	Message SlackMessage `json:"message"`
+}
+
+// SlackScheduledMessage - Slack scheduled message
+type SlackScheduledMessage struct {
+	ID          string `json:"id"`
+	ChannelID   string `json:"channel_id"`
+	PostAt      int64  `json:"post_at"`
+	DateCreated int64  `json:"date_created"`
+	Text        string `json:"text"`
+}
+
+// SlackScheduledMessages - Slack chat.scheduledMessages.list response
+type SlackScheduledMessages struct {
+	ScheduledMessages []SlackScheduledMessage `json:"scheduled_messages"`
+	ResponseMetadata  SlackResponseMetadata   `json:"response_metadata"`
+}
+
+// messageParams - Build the JSON params shared by chat.* calls, opts can be nil
+func messageParams(channel string, blocks []Block, text string, opts *MessageOptions) map[string]interface{} {
+	params := map[string]interface{}{
+		"channel": channel,
+		"text":    text}
+	if len(blocks) > 0 {
+		params["blocks"] = blocks
+	}
+	if opts == nil {
+		return params
+	}
+	if len(opts.ThreadTS) > 0 {
+		params["thread_ts"] = opts.ThreadTS
+	}
+	if opts.ReplyBroadcast {
+		params["reply_broadcast"] = true
+	}
+	if opts.UnfurlLinks != nil {
+		params["unfurl_links"] = *opts.UnfurlLinks
+	}
+	if opts.UnfurlMedia != nil {
+		params["unfurl_media"] = *opts.UnfurlMedia
+	}
+	if opts.Mrkdwn != nil {
+		params["mrkdwn"] = *opts.Mrkdwn
+	}
+	if len(opts.Username) > 0 {
+		params["username"] = opts.Username
+	}
+	if len(opts.IconEmoji) > 0 {
+		params["icon_emoji"] = opts.IconEmoji
+	}
+	if len(opts.IconURL) > 0 {
+		params["icon_url"] = opts.IconURL
+	}
+	if opts.Metadata != nil {
+		params["metadata"] = opts.Metadata
+	}
+	return params
+}
+
+// UpdateMessage - Update a slack message, opts can be nil
+func UpdateMessage(channel string, ts string, blocks []Block, text string, opts *MessageOptions, token string) (SlackChatResponse, error) {
+	var res SlackChatResponse
+	params := messageParams(channel, blocks, text, opts)
+	params["ts"] = ts
+	err := slackAPICall("chat.update", params, token, &res)
+	return res, err
+}
+
+// PostMessage - Post a message, opts can be nil
+func PostMessage(channel string, blocks []Block, text string, opts *MessageOptions, token string) (SlackChatResponse, error) {
+	var res SlackChatResponse
+	err := slackAPICall("chat.postMessage", messageParams(channel, blocks, text, opts), token, &res)
+	return res, err
+}
+
+// PostEphemeral - Post a message only visible to user, opts can be nil
+func PostEphemeral(channel string, user string, blocks []Block, text string, opts *MessageOptions, token string) (SlackChatResponse, error) {
+	var res struct {
+		MessageTS string `json:"message_ts"`
+	}
+	params := messageParams(channel, blocks, text, opts)
+	params["user"] = user
+	err := slackAPICall("chat.postEphemeral", params, token, &res)
+	return SlackChatResponse{Channel: channel, TS: res.MessageTS}, err
+}
+
+// DeleteMessage - Delete a message
+func DeleteMessage(channel string, ts string, token string) (SlackChatResponse, error) {
+	var res SlackChatResponse
+	form := url.Values{}
+	form.Set("channel", channel)
+	form.Set("ts", ts)
+	err := slackAPICall("chat.delete", form, token, &res)
+	return res, err
+}
+
+// ScheduleMessage - Schedule a message to be posted at postAt, opts can be nil
+func ScheduleMessage(channel string, postAt time.Time, blocks []Block, text string, opts *MessageOptions, token string) (SlackScheduledMessage, error) {
+	var res struct {
+		Channel            string       `json:"channel"`
+		ScheduledMessageID string       `json:"scheduled_message_id"`
+		PostAt             int64        `json:"post_at"`
+		Message            SlackMessage `json:"message"`
+	}
+	params := messageParams(channel, blocks, text, opts)
+	params["post_at"] = postAt.Unix()
+	err := slackAPICall("chat.scheduleMessage", params, token, &res)
+	return SlackScheduledMessage{
+		ID:        res.ScheduledMessageID,
+		ChannelID: res.Channel,
+		PostAt:    res.PostAt,
+		Text:      res.Message.Text}, err
+}
+
+// DeleteScheduledMessage - Delete a pending scheduled message
+func DeleteScheduledMessage(channel string, scheduledMessageID string, token string) error {
+	form := url.Values{}
+	form.Set("channel", channel)
+	form.Set("scheduled_message_id", scheduledMessageID)
+	return slackAPICall("chat.deleteScheduledMessage", form, token, nil)
+}
+
+// ListScheduledMessages - List a page of scheduled messages, channel and cursor can be empty
+func ListScheduledMessages(channel string, cursor string, token string) (SlackScheduledMessages, error) {
+	var res SlackScheduledMessages
+	form := url.Values{}
+	if len(channel) > 0 {
+		form.Set("channel", channel)
+	}
+	if len(cursor) > 0 {
+		form.Set("cursor", cursor)
+	}
+	err := slackAPICall("chat.scheduledMessages.list", form, token, &res)
+	return res, err
+}
+
+// GetPermalink - Get a permalink URL for a message
+func GetPermalink(channel string, ts string, token string) (string, error) {
+	var res struct {
+		Permalink string `json:"permalink"`
+	}
+	form := url.Values{}
+	form.Set("channel", channel)
+	form.Set("message_ts", ts)
+	err := slackAPICall("chat.getPermalink", form, token, &res)
+	return res.Permalink, err
+}
+
+// SlackUnfurl - Preview of an unfurled link
+type SlackUnfurl struct {
+	Blocks Blocks `json:"blocks"`
+}
+
+// Unfurl - Attach link previews, keyed by URL, to a message
+func Unfurl(channel string, ts string, unfurls map[string]SlackUnfurl, token string) error {
+	return slackAPICall("chat.unfurl", map[string]interface{}{
+		"channel": channel,
+		"ts":      ts,
+		"unfurls": unfurls}, token, nil)
+}
+
+// UnfurlByID - Attach link previews, keyed by URL, to a message being composed
+func UnfurlByID(unfurlID string, source string, unfurls map[string]SlackUnfurl, token string) error {
+	return slackAPICall("chat.unfurl", map[string]interface{}{
+		"unfurl_id": unfurlID,
+		"source":    source,
+		"unfurls":   unfurls}, token, nil)
+}
@@ -0,0 +1,210 @@
+package loafer
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// SlackConversationValue - Slack conversation topic or purpose
+type SlackConversationValue struct {
+	Value   string `json:"value"`
+	Creator string `json:"creator"`
+	LastSet int64  `json:"last_set"`
+}
+
+// SlackConversation - Slack conversation (channel, private channel, DM or MPDM)
+type SlackConversation struct {
+	ID                 string                 `json:"id"`
+	Name               string                 `json:"name"`
+	NameNormalized     string                 `json:"name_normalized"`
+	Created            int64                  `json:"created"`
+	Creator            string                 `json:"creator"`
+	User               string                 `json:"user"`
+	IsChannel          bool                   `json:"is_channel"`
+	IsGroup            bool                   `json:"is_group"`
+	IsIM               bool                   `json:"is_im"`
+	IsMPIM             bool                   `json:"is_mpim"`
+	IsPrivate          bool                   `json:"is_private"`
+	IsArchived         bool                   `json:"is_archived"`
+	IsGeneral          bool                   `json:"is_general"`
+	IsShared           bool                   `json:"is_shared"`
+	IsExtShared        bool                   `json:"is_ext_shared"`
+	IsOrgShared        bool                   `json:"is_org_shared"`
+	IsMember           bool                   `json:"is_member"`
+	ContextTeamID      string                 `json:"context_team_id"`
+	Topic              SlackConversationValue `json:"topic"`
+	Purpose            SlackConversationValue `json:"purpose"`
+	PreviousNames      []string               `json:"previous_names"`
+	NumMembers         int                    `json:"num_members"`
+	LastRead           string                 `json:"last_read"`
+	UnreadCount        int                    `json:"unread_count"`
+	UnreadCountDisplay int                    `json:"unread_count_display"`
+}
+
+// SlackConversationResponse - Slack conversations.* response carrying a channel
+type SlackConversationResponse struct {
+	Channel SlackConversation `json:"channel"`
+}
+
+// SlackHistoryOptions - Slack conversations.history and conversations.replies options
+type SlackHistoryOptions struct {
+	Cursor             string // Cursor from a previous page's ResponseMetadata.NextCursor
+	Latest             string // Only messages before this ts
+	Oldest             string // Only messages after this ts
+	Inclusive          bool   // Include messages with latest or oldest ts
+	Limit              uint16 // Maximum number of messages to return
+	IncludeAllMetadata bool   // Return the metadata of messages posted by any app
+}
+
+// SlackConversationHistory - Slack conversations.history and conversations.replies response
+type SlackConversationHistory struct {
+	Messages         []SlackMessage        `json:"messages"`
+	HasMore          bool                  `json:"has_more"`
+	ResponseMetadata SlackResponseMetadata `json:"response_metadata"`
+}
+
+// slackConversationCall - Calls a conversations.* API that responds with a channel
+func slackConversationCall(method string, form url.Values, token string) (SlackConversation, error) {
+	var res SlackConversationResponse
+	err := slackAPICall(method, form, token, &res)
+	return res.Channel, err
+}
+
+// setHistoryOptions - Set history options onto form
+func setHistoryOptions(form url.Values, opts *SlackHistoryOptions) {
+	if opts == nil {
+		return
+	}
+	if len(opts.Cursor) > 0 {
+		form.Set("cursor", opts.Cursor)
+	}
+	if len(opts.Latest) > 0 {
+		form.Set("latest", opts.Latest)
+	}
+	if len(opts.Oldest) > 0 {
+		form.Set("oldest", opts.Oldest)
+	}
+	if opts.Inclusive {
+		form.Set("inclusive", "true")
+	}
+	if opts.Limit > 0 {
+		form.Set("limit", strconv.Itoa(int(opts.Limit)))
+	}
+	if opts.IncludeAllMetadata {
+		form.Set("include_all_metadata", "true")
+	}
+}
+
+// CreateConversation - Create a public or private channel
+func CreateConversation(name string, isPrivate bool, token string) (SlackConversation, error) {
+	form := url.Values{}
+	form.Set("name", name)
+	form.Set("is_private", strconv.FormatBool(isPrivate))
+	return slackConversationCall("conversations.create", form, token)
+}
+
+// GetConversationInfo - Get information about a conversation
+func GetConversationInfo(channel string, token string) (SlackConversation, error) {
+	form := url.Values{}
+	form.Set("channel", channel)
+	form.Set("include_num_members", "true")
+	return slackConversationCall("conversations.info", form, token)
+}
+
+// InviteToConversation - Invite users to a channel
+func InviteToConversation(channel string, users []string, token string) (SlackConversation, error) {
+	form := url.Values{}
+	form.Set("channel", channel)
+	form.Set("users", strings.Join(users, ","))
+	return slackConversationCall("conversations.invite", form, token)
+}
+
+// KickFromConversation - Remove a user from a channel
+func KickFromConversation(channel string, user string, token string) error {
+	form := url.Values{}
+	form.Set("channel", channel)
+	form.Set("user", user)
+	return slackAPICall("conversations.kick", form, token, nil)
+}
+
+// JoinConversation - Join an existing channel
+func JoinConversation(channel string, token string) (SlackConversation, error) {
+	form := url.Values{}
+	form.Set("channel", channel)
+	return slackConversationCall("conversations.join", form, token)
+}
+
+// LeaveConversation - Leave a channel
+func LeaveConversation(channel string, token string) error {
+	form := url.Values{}
+	form.Set("channel", channel)
+	return slackAPICall("conversations.leave", form, token, nil)
+}
+
+// RenameConversation - Rename a channel
+func RenameConversation(channel string, name string, token string) (SlackConversation, error) {
+	form := url.Values{}
+	form.Set("channel", channel)
+	form.Set("name", name)
+	return slackConversationCall("conversations.rename", form, token)
+}
+
+// SetConversationTopic - Set the topic of a channel
+func SetConversationTopic(channel string, topic string, token string) (SlackConversation, error) {
+	form := url.Values{}
+	form.Set("channel", channel)
+	form.Set("topic", topic)
+	return slackConversationCall("conversations.setTopic", form, token)
+}
+
+// SetConversationPurpose - Set the purpose of a channel
+func SetConversationPurpose(channel string, purpose string, token string) (SlackConversation, error) {
+	form := url.Values{}
+	form.Set("channel", channel)
+	form.Set("purpose", purpose)
+	return slackConversationCall("conversations.setPurpose", form, token)
+}
+
+// ArchiveConversation - Archive a channel
+func ArchiveConversation(channel string, token string) error {
+	form := url.Values{}
+	form.Set("channel", channel)
+	return slackAPICall("conversations.archive", form, token, nil)
+}
+
+// UnarchiveConversation - Unarchive a channel
+func UnarchiveConversation(channel string, token string) error {
+	form := url.Values{}
+	form.Set("channel", channel)
+	return slackAPICall("conversations.unarchive", form, token, nil)
+}
+
+// OpenConversation - Open a DM (one user) or MPDM (several users)
+func OpenConversation(users []string, token string) (SlackConversation, error) {
+	form := url.Values{}
+	form.Set("users", strings.Join(users, ","))
+	form.Set("return_im", "true")
+	return slackConversationCall("conversations.open", form, token)
+}
+
+// GetConversationHistory - Get a page of messages from a conversation, opts can be nil
+func GetConversationHistory(channel string, opts *SlackHistoryOptions, token string) (SlackConversationHistory, error) {
+	var history SlackConversationHistory
+	form := url.Values{}
+	form.Set("channel", channel)
+	setHistoryOptions(form, opts)
+	err := slackAPICall("conversations.history", form, token, &history)
+	return history, err
+}
+
+// GetConversationReplies - Get a page of messages from a thread, opts can be nil
+func GetConversationReplies(channel string, ts string, opts *SlackHistoryOptions, token string) (SlackConversationHistory, error) {
+	var history SlackConversationHistory
+	form := url.Values{}
+	form.Set("channel", channel)
+	form.Set("ts", ts)
+	setHistoryOptions(form, opts)
+	err := slackAPICall("conversations.replies", form, token, &history)
+	return history, err
+}
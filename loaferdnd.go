@@ -0,0 +1,65 @@
+package loafer
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SlackDNDStatus - Slack Do Not Disturb status of a user
+type SlackDNDStatus struct {
+	DNDEnabled         bool  `json:"dnd_enabled"`
+	NextDNDStartTS     int64 `json:"next_dnd_start_ts"`
+	NextDNDEndTS       int64 `json:"next_dnd_end_ts"`
+	SnoozeEnabled      bool  `json:"snooze_enabled"`
+	SnoozeEndtime      int64 `json:"snooze_endtime"`
+	SnoozeRemaining    int64 `json:"snooze_remaining"`
+	SnoozeIsIndefinite bool  `json:"snooze_is_indefinite"`
+}
+
+// Active - Whether notifications are paused at now, by a snooze or by the scheduled DND window
+func (s SlackDNDStatus) Active(now time.Time) bool {
+	if s.SnoozeEnabled && (s.SnoozeIsIndefinite || now.Unix() < s.SnoozeEndtime) {
+		return true
+	}
+	return s.DNDEnabled && now.Unix() >= s.NextDNDStartTS && now.Unix() < s.NextDNDEndTS
+}
+
+// GetDNDInfo - Get the Do Not Disturb status of a user, user can be empty for the token's user
+func GetDNDInfo(user string, token string) (SlackDNDStatus, error) {
+	var res SlackDNDStatus
+	form := url.Values{}
+	if len(user) > 0 {
+		form.Set("user", user)
+	}
+	err := slackAPICall("dnd.info", form, token, &res)
+	return res, err
+}
+
+// GetTeamDNDInfo - Get the Do Not Disturb status of users, by user id
+func GetTeamDNDInfo(users []string, token string) (map[string]SlackDNDStatus, error) {
+	var res struct {
+		Users map[string]SlackDNDStatus `json:"users"`
+	}
+	form := url.Values{}
+	form.Set("users", strings.Join(users, ","))
+	err := slackAPICall("dnd.teamInfo", form, token, &res)
+	return res.Users, err
+}
+
+// SetSnooze - Snooze notifications of the token's user for minutes, requires a user token
+func SetSnooze(minutes int, token string) (SlackDNDStatus, error) {
+	var res SlackDNDStatus
+	form := url.Values{}
+	form.Set("num_minutes", strconv.Itoa(minutes))
+	err := slackAPICall("dnd.setSnooze", form, token, &res)
+	return res, err
+}
+
+// EndSnooze - End the snooze of the token's user, requires a user token
+func EndSnooze(token string) (SlackDNDStatus, error) {
+	var res SlackDNDStatus
+	err := slackAPICall("dnd.endSnooze", nil, token, &res)
+	return res, err
+}
@@ -0,0 +1,442 @@
+package loafer
+
+import "time"
+
+// SlackConfirmDialog - Slack confirm dialog shown before an element's action runs
+type SlackConfirmDialog struct {
+	Title   *SlackBlockText `json:"title,omitempty"`
+	Text    *SlackBlockText `json:"text,omitempty"`
+	Confirm *SlackBlockText `json:"confirm,omitempty"`
+	Deny    *SlackBlockText `json:"deny,omitempty"`
+	Style   string          `json:"style,omitempty"`
+}
+
+// SlackOptionGroup - Slack group of select options
+type SlackOptionGroup struct {
+	Label   *SlackBlockText    `json:"label,omitempty"`
+	Options []SlackInputOption `json:"options,omitempty"`
+}
+
+// SlackDispatchActionConfig - When a text input dispatches block_actions, on_enter_pressed and/or on_character_entered
+type SlackDispatchActionConfig struct {
+	TriggerActionsOn []string `json:"trigger_actions_on,omitempty"`
+}
+
+// SlackConversationFilter - Filter of the conversations listed by a conversations select, include is im, mpim, private and/or public
+type SlackConversationFilter struct {
+	Include                       []string `json:"include,omitempty"`
+	ExcludeExternalSharedChannels bool     `json:"exclude_external_shared_channels,omitempty"`
+	ExcludeBotUsers               bool     `json:"exclude_bot_users,omitempty"`
+}
+
+// SlackOverflowMenu - Slack overflow menu element
+type SlackOverflowMenu struct {
+	Type     string              `json:"type,omitempty"`
+	ActionID string              `json:"action_id,omitempty"`
+	Options  []SlackInputOption  `json:"options,omitempty"`
+	Confirm  *SlackConfirmDialog `json:"confirm,omitempty"`
+}
+
+// SlackPlainTextInput - Slack plain text input element
+type SlackPlainTextInput struct {
+	Type                 string                     `json:"type,omitempty"`
+	ActionID             string                     `json:"action_id,omitempty"`
+	InitialValue         string                     `json:"initial_value,omitempty"`
+	IsMultiline          bool                       `json:"multiline,omitempty"`
+	MinLength            uint16                     `json:"min_length,omitempty"`
+	MaxLength            uint16                     `json:"max_length,omitempty"`
+	Placeholder          *SlackBlockText            `json:"placeholder,omitempty"`
+	DispatchActionConfig *SlackDispatchActionConfig `json:"dispatch_action_config,omitempty"`
+	FocusOnLoad          bool                       `json:"focus_on_load,omitempty"`
+}
+
+// SlackStaticSelect - Slack static options select element, single or multi
+type SlackStaticSelect struct {
+	Type             string              `json:"type,omitempty"`
+	ActionID         string              `json:"action_id,omitempty"`
+	Placeholder      *SlackBlockText     `json:"placeholder,omitempty"`
+	Options          []SlackInputOption  `json:"options,omitempty"`
+	OptionGroups     []SlackOptionGroup  `json:"option_groups,omitempty"`
+	InitialOption    *SlackInputOption   `json:"initial_option,omitempty"`
+	InitialOptions   []SlackInputOption  `json:"initial_options,omitempty"`
+	MaxSelectedItems uint16              `json:"max_selected_items,omitempty"`
+	FocusOnLoad      bool                `json:"focus_on_load,omitempty"`
+	Confirm          *SlackConfirmDialog `json:"confirm,omitempty"`
+}
+
+// SlackUsersSelect - Slack user select element, single or multi
+type SlackUsersSelect struct {
+	Type             string              `json:"type,omitempty"`
+	ActionID         string              `json:"action_id,omitempty"`
+	Placeholder      *SlackBlockText     `json:"placeholder,omitempty"`
+	InitialUser      string              `json:"initial_user,omitempty"`
+	InitialUsers     []string            `json:"initial_users,omitempty"`
+	MaxSelectedItems uint16              `json:"max_selected_items,omitempty"`
+	FocusOnLoad      bool                `json:"focus_on_load,omitempty"`
+	Confirm          *SlackConfirmDialog `json:"confirm,omitempty"`
+}
+
+// SlackConversationsSelect - Slack conversation select element, single or multi
+type SlackConversationsSelect struct {
+	Type                         string                   `json:"type,omitempty"`
+	ActionID                     string                   `json:"action_id,omitempty"`
+	Placeholder                  *SlackBlockText          `json:"placeholder,omitempty"`
+	InitialConversation          string                   `json:"initial_conversation,omitempty"`
+	InitialConversations         []string                 `json:"initial_conversations,omitempty"`
+	DefaultToCurrentConversation bool                     `json:"default_to_current_conversation,omitempty"`
+	Filter                       *SlackConversationFilter `json:"filter,omitempty"`
+	MaxSelectedItems             uint16                   `json:"max_selected_items,omitempty"`
+	ResponseURLEnabled           bool                     `json:"response_url_enabled,omitempty"`
+	FocusOnLoad                  bool                     `json:"focus_on_load,omitempty"`
+	Confirm                      *SlackConfirmDialog      `json:"confirm,omitempty"`
+}
+
+// SlackDatePicker - Slack date picker element, initial date is YYYY-MM-DD
+type SlackDatePicker struct {
+	Type        string              `json:"type,omitempty"`
+	ActionID    string              `json:"action_id,omitempty"`
+	InitialDate string              `json:"initial_date,omitempty"`
+	Placeholder *SlackBlockText     `json:"placeholder,omitempty"`
+	FocusOnLoad bool                `json:"focus_on_load,omitempty"`
+	Confirm     *SlackConfirmDialog `json:"confirm,omitempty"`
+}
+
+// SlackTimePicker - Slack time picker element, initial time is HH:mm
+type SlackTimePicker struct {
+	Type        string              `json:"type,omitempty"`
+	ActionID    string              `json:"action_id,omitempty"`
+	InitialTime string              `json:"initial_time,omitempty"`
+	Timezone    string              `json:"timezone,omitempty"`
+	Placeholder *SlackBlockText     `json:"placeholder,omitempty"`
+	FocusOnLoad bool                `json:"focus_on_load,omitempty"`
+	Confirm     *SlackConfirmDialog `json:"confirm,omitempty"`
+}
+
+// SlackCheckboxes - Slack checkbox group element
+type SlackCheckboxes struct {
+	Type           string              `json:"type,omitempty"`
+	ActionID       string              `json:"action_id,omitempty"`
+	Options        []SlackInputOption  `json:"options,omitempty"`
+	InitialOptions []SlackInputOption  `json:"initial_options,omitempty"`
+	FocusOnLoad    bool                `json:"focus_on_load,omitempty"`
+	Confirm        *SlackConfirmDialog `json:"confirm,omitempty"`
+}
+
+// SlackRadioButtons - Slack radio button group element
+type SlackRadioButtons struct {
+	Type          string              `json:"type,omitempty"`
+	ActionID      string              `json:"action_id,omitempty"`
+	Options       []SlackInputOption  `json:"options,omitempty"`
+	InitialOption *SlackInputOption   `json:"initial_option,omitempty"`
+	FocusOnLoad   bool                `json:"focus_on_load,omitempty"`
+	Confirm       *SlackConfirmDialog `json:"confirm,omitempty"`
+}
+
+// SlackExternalSelect - Slack external data source select element, single or multi
+type SlackExternalSelect struct {
+	Type             string              `json:"type,omitempty"`
+	ActionID         string              `json:"action_id,omitempty"`
+	Placeholder      *SlackBlockText     `json:"placeholder,omitempty"`
+	InitialOption    *SlackInputOption   `json:"initial_option,omitempty"`
+	InitialOptions   []SlackInputOption  `json:"initial_options,omitempty"`
+	MinQueryLength   *uint16             `json:"min_query_length,omitempty"`
+	MaxSelectedItems uint16              `json:"max_selected_items,omitempty"`
+	FocusOnLoad      bool                `json:"focus_on_load,omitempty"`
+	Confirm          *SlackConfirmDialog `json:"confirm,omitempty"`
+}
+
+// SlackChannelSelect - Slack public channel select element, single or multi
+type SlackChannelSelect struct {
+	Type               string              `json:"type,omitempty"`
+	ActionID           string              `json:"action_id,omitempty"`
+	Placeholder        *SlackBlockText     `json:"placeholder,omitempty"`
+	InitialChannel     string              `json:"initial_channel,omitempty"`
+	InitialChannels    []string            `json:"initial_channels,omitempty"`
+	MaxSelectedItems   uint16              `json:"max_selected_items,omitempty"`
+	ResponseURLEnabled bool                `json:"response_url_enabled,omitempty"`
+	FocusOnLoad        bool                `json:"focus_on_load,omitempty"`
+	Confirm            *SlackConfirmDialog `json:"confirm,omitempty"`
+}
+
+// SlackDatetimePicker - Slack date and time picker element
+type SlackDatetimePicker struct {
+	Type            string              `json:"type,omitempty"`
+	ActionID        string              `json:"action_id,omitempty"`
+	InitialDateTime int64               `json:"initial_date_time,omitempty"`
+	FocusOnLoad     bool                `json:"focus_on_load,omitempty"`
+	Confirm         *SlackConfirmDialog `json:"confirm,omitempty"`
+}
+
+// SlackNumberInput - Slack number input element, min, max and initial values are decimal strings
+type SlackNumberInput struct {
+	Type                 string                     `json:"type,omitempty"`
+	ActionID             string                     `json:"action_id,omitempty"`
+	IsDecimalAllowed     bool                       `json:"is_decimal_allowed"`
+	InitialValue         string                     `json:"initial_value,omitempty"`
+	MinValue             string                     `json:"min_value,omitempty"`
+	MaxValue             string                     `json:"max_value,omitempty"`
+	Placeholder          *SlackBlockText            `json:"placeholder,omitempty"`
+	DispatchActionConfig *SlackDispatchActionConfig `json:"dispatch_action_config,omitempty"`
+	FocusOnLoad          bool                       `json:"focus_on_load,omitempty"`
+}
+
+// SlackEmailInput - Slack email input element
+type SlackEmailInput struct {
+	Type                 string                     `json:"type,omitempty"`
+	ActionID             string                     `json:"action_id,omitempty"`
+	InitialValue         string                     `json:"initial_value,omitempty"`
+	Placeholder          *SlackBlockText            `json:"placeholder,omitempty"`
+	DispatchActionConfig *SlackDispatchActionConfig `json:"dispatch_action_config,omitempty"`
+	FocusOnLoad          bool                       `json:"focus_on_load,omitempty"`
+}
+
+// SlackURLInput - Slack URL input element
+type SlackURLInput struct {
+	Type                 string                     `json:"type,omitempty"`
+	ActionID             string                     `json:"action_id,omitempty"`
+	InitialValue         string                     `json:"initial_value,omitempty"`
+	Placeholder          *SlackBlockText            `json:"placeholder,omitempty"`
+	DispatchActionConfig *SlackDispatchActionConfig `json:"dispatch_action_config,omitempty"`
+	FocusOnLoad          bool                       `json:"focus_on_load,omitempty"`
+}
+
+// SlackRichTextInput - Slack rich text input element
+type SlackRichTextInput struct {
+	Type                 string                     `json:"type,omitempty"`
+	ActionID             string                     `json:"action_id,omitempty"`
+	InitialValue         *SlackRichTextBlock        `json:"initial_value,omitempty"`
+	Placeholder          *SlackBlockText            `json:"placeholder,omitempty"`
+	DispatchActionConfig *SlackDispatchActionConfig `json:"dispatch_action_config,omitempty"`
+	FocusOnLoad          bool                       `json:"focus_on_load,omitempty"`
+}
+
+// SlackFileInput - Slack file input element
+type SlackFileInput struct {
+	Type      string   `json:"type,omitempty"`
+	ActionID  string   `json:"action_id,omitempty"`
+	FileTypes []string `json:"filetypes,omitempty"`
+	MaxFiles  uint8    `json:"max_files,omitempty"`
+}
+
+// SlackImageElement - Slack image element, for context blocks and section accessories
+type SlackImageElement struct {
+	Type     string `json:"type,omitempty"`
+	ImageURL string `json:"image_url,omitempty"`
+	AltText  string `json:"alt_text,omitempty"`
+}
+
+// SlackInputBlock - Slack input block holding any input element
+type SlackInputBlock struct {
+	Type           string          `json:"type,omitempty"`
+	BlockID        string          `json:"block_id,omitempty"`
+	Label          *SlackBlockText `json:"label,omitempty"`
+	Element        InputElement    `json:"element,omitempty"`
+	DispatchAction bool            `json:"dispatch_action,omitempty"`
+	Hint           *SlackBlockText `json:"hint,omitempty"`
+	Optional       bool            `json:"optional,omitempty"`
+}
+
+// makePlainText - Make a plain text object with emoji enabled
+func makePlainText(text string) *SlackBlockText {
+	isEmojiSupported := true
+	return &SlackBlockText{
+		Type:  "plain_text",
+		Text:  text,
+		Emoji: &isEmojiSupported}
+}
+
+// MakeSlackConfirmDialog - Make a slack confirm dialog
+func MakeSlackConfirmDialog(title string, text string, confirmText string, denyText string) *SlackConfirmDialog {
+	return &SlackConfirmDialog{
+		Title: &SlackBlockText{Type: "plain_text", Text: title},
+		Text: &SlackBlockText{
+			Type: "mrkdwn",
+			Text: text},
+		Confirm: &SlackBlockText{Type: "plain_text", Text: confirmText},
+		Deny:    &SlackBlockText{Type: "plain_text", Text: denyText}}
+}
+
+// MakeSlackOverflowMenu - Make a slack overflow menu
+func MakeSlackOverflowMenu(options []SlackInputOption, actionID string) SlackOverflowMenu {
+	return SlackOverflowMenu{
+		Type:     "overflow",
+		ActionID: actionID,
+		Options:  options}
+}
+
+// MakeSlackPlainTextInput - Make a slack plain text input
+func MakeSlackPlainTextInput(placeholder string, actionID string, isMultiline bool) SlackPlainTextInput {
+	return SlackPlainTextInput{
+		Type:        "plain_text_input",
+		ActionID:    actionID,
+		IsMultiline: isMultiline,
+		Placeholder: makePlainText(placeholder)}
+}
+
+// MakeSlackStaticSelect - Make a slack static select of options
+func MakeSlackStaticSelect(placeholder string, options []SlackInputOption, actionID string, isMulti bool) SlackStaticSelect {
+	selectType := "static_select"
+	if isMulti {
+		selectType = "multi_static_select"
+	}
+	return SlackStaticSelect{
+		Type:        selectType,
+		ActionID:    actionID,
+		Placeholder: makePlainText(placeholder),
+		Options:     options}
+}
+
+// MakeSlackUsersSelect - Make a slack user select
+func MakeSlackUsersSelect(placeholder string, actionID string, isMulti bool) SlackUsersSelect {
+	selectType := "users_select"
+	if isMulti {
+		selectType = "multi_users_select"
+	}
+	return SlackUsersSelect{
+		Type:        selectType,
+		ActionID:    actionID,
+		Placeholder: makePlainText(placeholder)}
+}
+
+// MakeSlackConversationsSelect - Make a slack conversation select
+func MakeSlackConversationsSelect(placeholder string, actionID string, isMulti bool) SlackConversationsSelect {
+	selectType := "conversations_select"
+	if isMulti {
+		selectType = "multi_conversations_select"
+	}
+	return SlackConversationsSelect{
+		Type:        selectType,
+		ActionID:    actionID,
+		Placeholder: makePlainText(placeholder)}
+}
+
+// MakeSlackDatePicker - Make a slack date picker, initialDate (YYYY-MM-DD) can be empty
+func MakeSlackDatePicker(initialDate string, actionID string) SlackDatePicker {
+	return SlackDatePicker{
+		Type:        "datepicker",
+		ActionID:    actionID,
+		InitialDate: initialDate}
+}
+
+// MakeSlackTimePicker - Make a slack time picker, initialTime (HH:mm) can be empty
+func MakeSlackTimePicker(initialTime string, actionID string) SlackTimePicker {
+	return SlackTimePicker{
+		Type:        "timepicker",
+		ActionID:    actionID,
+		InitialTime: initialTime}
+}
+
+// MakeSlackCheckboxes - Make a slack checkbox group
+func MakeSlackCheckboxes(options []SlackInputOption, actionID string) SlackCheckboxes {
+	return SlackCheckboxes{
+		Type:     "checkboxes",
+		ActionID: actionID,
+		Options:  options}
+}
+
+// MakeSlackRadioButtons - Make a slack radio button group
+func MakeSlackRadioButtons(options []SlackInputOption, actionID string) SlackRadioButtons {
+	return SlackRadioButtons{
+		Type:     "radio_buttons",
+		ActionID: actionID,
+		Options:  options}
+}
+
+// MakeSlackExternalSelect - Make a slack external select, options are loaded from the app's options load URL
+func MakeSlackExternalSelect(placeholder string, minQueryLength uint16, actionID string, isMulti bool) SlackExternalSelect {
+	selectType := "external_select"
+	if isMulti {
+		selectType = "multi_external_select"
+	}
+	return SlackExternalSelect{
+		Type:           selectType,
+		ActionID:       actionID,
+		Placeholder:    makePlainText(placeholder),
+		MinQueryLength: &minQueryLength}
+}
+
+// MakeSlackChannelSelect - Make a slack channel select, initialChannels can be empty
+func MakeSlackChannelSelect(placeholder string, initialChannels []string, actionID string, isMulti bool) SlackChannelSelect {
+	channelSelect := SlackChannelSelect{
+		Type:        "channels_select",
+		ActionID:    actionID,
+		Placeholder: makePlainText(placeholder)}
+	if isMulti {
+		channelSelect.Type = "multi_channels_select"
+		channelSelect.InitialChannels = initialChannels
+	} else if len(initialChannels) > 0 {
+		channelSelect.InitialChannel = initialChannels[0]
+	}
+	return channelSelect
+}
+
+// MakeSlackDatetimePicker - Make a slack date and time picker, initial can be the zero time
+func MakeSlackDatetimePicker(initial time.Time, actionID string) SlackDatetimePicker {
+	picker := SlackDatetimePicker{
+		Type:     "datetimepicker",
+		ActionID: actionID}
+	if !initial.IsZero() {
+		picker.InitialDateTime = initial.Unix()
+	}
+	return picker
+}
+
+// MakeSlackNumberInput - Make a slack number input
+func MakeSlackNumberInput(placeholder string, isDecimalAllowed bool, actionID string) SlackNumberInput {
+	return SlackNumberInput{
+		Type:             "number_input",
+		ActionID:         actionID,
+		IsDecimalAllowed: isDecimalAllowed,
+		Placeholder:      makePlainText(placeholder)}
+}
+
+// MakeSlackEmailInput - Make a slack email input
+func MakeSlackEmailInput(placeholder string, actionID string) SlackEmailInput {
+	return SlackEmailInput{
+		Type:        "email_text_input",
+		ActionID:    actionID,
+		Placeholder: makePlainText(placeholder)}
+}
+
+// MakeSlackURLInput - Make a slack URL input
+func MakeSlackURLInput(placeholder string, actionID string) SlackURLInput {
+	return SlackURLInput{
+		Type:        "url_text_input",
+		ActionID:    actionID,
+		Placeholder: makePlainText(placeholder)}
+}
+
+// MakeSlackRichTextInput - Make a slack rich text input
+func MakeSlackRichTextInput(placeholder string, actionID string) SlackRichTextInput {
+	return SlackRichTextInput{
+		Type:        "rich_text_input",
+		ActionID:    actionID,
+		Placeholder: makePlainText(placeholder)}
+}
+
+// MakeSlackFileInput - Make a slack file input, fileTypes can be empty to accept any file
+func MakeSlackFileInput(fileTypes []string, maxFiles uint8, actionID string) SlackFileInput {
+	return SlackFileInput{
+		Type:      "file_input",
+		ActionID:  actionID,
+		FileTypes: fileTypes,
+		MaxFiles:  maxFiles}
+}
+
+// MakeSlackImageElement - Make a slack image element
+func MakeSlackImageElement(imageURL string, altText string) SlackImageElement {
+	return SlackImageElement{
+		Type:     "image",
+		ImageURL: imageURL,
+		AltText:  altText}
+}
+
+// MakeSlackInput - Make a slack input block holding element
+func MakeSlackInput(label string, element InputElement, blockID string, isOptional bool) SlackInputBlock {
+	return SlackInputBlock{
+		Type:     "input",
+		BlockID:  blockID,
+		Label:    makePlainText(label),
+		Element:  element,
+		Optional: isOptional}
+}
@@ -0,0 +1,209 @@
+package loafer
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"path"
+	"strings"
+)
+
+// SlackEventEnvelope - Slack Events API request
+type SlackEventEnvelope struct {
+	Type      string          `json:"type"`
+	Token     string          `json:"token"`
+	Challenge string          `json:"challenge"`
+	TeamID    string          `json:"team_id"`
+	APIAppID  string          `json:"api_app_id"`
+	EventID   string          `json:"event_id"`
+	EventTime int64           `json:"event_time"`
+	Event     json.RawMessage `json:"event"`
+}
+
+// SlackEventType - Type of an Events API event
+type SlackEventType struct {
+	Type string `json:"type"`
+}
+
+// SlackMetadataEvent - Slack message_metadata_posted, message_metadata_updated and message_metadata_deleted event
+type SlackMetadataEvent struct {
+	Type             string                `json:"type"`
+	AppID            string                `json:"app_id"`
+	BotID            string                `json:"bot_id"`
+	UserID           string                `json:"user_id"`
+	TeamID           string                `json:"team_id"`
+	ChannelID        string                `json:"channel_id"`
+	MessageTS        string                `json:"message_ts"`
+	EventTS          string                `json:"event_ts"`
+	Metadata         *SlackMessageMetadata `json:"metadata"`          // Posted metadata
+	NewMetadata      *SlackMessageMetadata `json:"new_metadata"`      // Metadata after an update
+	PreviousMetadata *SlackMessageMetadata `json:"previous_metadata"` // Metadata before an update or delete
+}
+
+// MetadataEventType - event_type of the metadata the event is about
+func (e *SlackMetadataEvent) MetadataEventType() string {
+	for _, m := range []*SlackMessageMetadata{e.Metadata, e.NewMetadata, e.PreviousMetadata} {
+		if m != nil {
+			return m.EventType
+		}
+	}
+	return ""
+}
+
+// SlackSharedLink - Link of a link_shared event
+type SlackSharedLink struct {
+	Domain string `json:"domain"`
+	URL    string `json:"url"`
+}
+
+// SlackLinkSharedEvent - Slack link_shared event
+type SlackLinkSharedEvent struct {
+	Type      string            `json:"type"`
+	Channel   string            `json:"channel"`
+	User      string            `json:"user"`
+	MessageTS string            `json:"message_ts"`
+	ThreadTS  string            `json:"thread_ts"`
+	UnfurlID  string            `json:"unfurl_id"`
+	Source    string            `json:"source"`
+	EventTS   string            `json:"event_ts"`
+	Links     []SlackSharedLink `json:"links"`
+}
+
+// slackLinkListener - Link unfurl handler of a domain pattern
+type slackLinkListener struct {
+	pattern string
+	handler func(ctx *SlackContext, link string) []Block
+}
+
+// OnLinkShared - Add handler unfurling links whose domain matches domainPattern (path.Match syntax, e.g. *.example.com).
+// The handler returns the blocks of the preview, or no blocks to leave the link alone. The first matching handler wins
+func (a *SlackApp) OnLinkShared(domainPattern string, handler func(ctx *SlackContext, link string) []Block) {
+	a.linkListeners = append(a.linkListeners, slackLinkListener{pattern: strings.ToLower(domainPattern), handler: handler})
+}
+
+// findLinkListener - Handler of the first pattern matching domain
+func (a *SlackApp) findLinkListener(domain string) func(ctx *SlackContext, link string) []Block {
+	domain = strings.ToLower(domain)
+	for _, l := range a.linkListeners {
+		if matched, _ := path.Match(l.pattern, domain); matched {
+			return l.handler
+		}
+	}
+	return nil
+}
+
+// unfurlLinks - Build the previews of the event's links and unfurl them
+func (a *SlackApp) unfurlLinks(ctx *SlackContext, event SlackLinkSharedEvent) {
+	unfurls := make(map[string]SlackUnfurl)
+	for _, link := range event.Links {
+		handler := a.findLinkListener(link.Domain)
+		if handler == nil {
+			continue
+		}
+		if blocks := handler(ctx, link.URL); len(blocks) > 0 {
+			unfurls[link.URL] = SlackUnfurl{Blocks: blocks}
+		}
+	}
+	if len(unfurls) == 0 {
+		return
+	}
+	var err error
+	if len(event.UnfurlID) > 0 && len(event.Source) > 0 {
+		err = UnfurlByID(event.UnfurlID, event.Source, unfurls, ctx.Token)
+	} else {
+		err = Unfurl(event.Channel, event.MessageTS, unfurls, ctx.Token)
+	}
+	if err != nil {
+		fmt.Printf("Failed to unfurl links: %s\n", err.Error())
+	}
+}
+
+// handleEvent - Run the handler of an acknowledged event in the background, logging a panic instead of crashing the app
+func handleEvent(eventType string, handler func()) {
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				fmt.Printf("Handler of %s event panicked: %v\n", eventType, r)
+			}
+		}()
+		handler()
+	}()
+}
+
+// OnMessageMetadata - Add handler to message metadata events base on the metadata event_type.
+// Handlers run after the event has been acknowledged, so ctx.Res is nil
+func (a *SlackApp) OnMessageMetadata(eventType string, handler func(ctx *SlackContext, event SlackMetadataEvent)) {
+	if a.metadataListeners == nil {
+		a.metadataListeners = make(map[string]func(ctx *SlackContext, event SlackMetadataEvent))
+	}
+	a.metadataListeners[eventType] = handler
+}
+
+// events - Slack App Events API handler
+func (a *SlackApp) events(res http.ResponseWriter, req *http.Request) {
+	var envelope SlackEventEnvelope
+	var eventType SlackEventType
+	bodyText, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		Response(&SlackContext{Res: res}, http.StatusBadRequest, []byte("Invalid Body"), nil)
+		return
+	}
+	defer req.Body.Close()
+	isAuthorizedCaller := a.checkSlackSecret(req.Header.Get("X-Slack-Signature"), req.Header.Get("X-Slack-Request-TimeStamp"), string(bodyText))
+	if !isAuthorizedCaller {
+		Response(&SlackContext{Res: res}, http.StatusUnauthorized, []byte("Unauthorized"), nil)
+		return
+	}
+	err = json.Unmarshal(bodyText, &envelope)
+	if err != nil {
+		Response(&SlackContext{Res: res}, http.StatusBadRequest, []byte("Invalid JSON format"), nil)
+		return
+	}
+	if envelope.Type == "url_verification" {
+		Response(&SlackContext{Res: res}, http.StatusOK, []byte(envelope.Challenge), map[string]string{
+			"Content-Type": "text/plain"})
+		return
+	}
+	err = json.Unmarshal(envelope.Event, &eventType)
+	if envelope.Type != "event_callback" || err != nil {
+		Response(&SlackContext{Res: res}, http.StatusBadRequest, []byte("Unrecognized event"), nil)
+		return
+	}
+	accessToken := a.findTokenForWorkspace(envelope.TeamID)
+	if accessToken == nil {
+		fmt.Printf("App not installed for workspace: %s\n", envelope.TeamID)
+		Response(&SlackContext{Res: res}, http.StatusBadRequest, []byte("App not installed for workspace"), nil)
+		return
+	}
+	ctx := &SlackContext{
+		Body:      bodyText,
+		Token:     accessToken.Token,
+		Workspace: envelope.TeamID,
+		Req:       req}
+	switch eventType.Type {
+	case "message_metadata_posted", "message_metadata_updated", "message_metadata_deleted":
+		var event SlackMetadataEvent
+		err = json.Unmarshal(envelope.Event, &event)
+		if err != nil {
+			Response(&SlackContext{Res: res}, http.StatusBadRequest, []byte("Invalid JSON format"), nil)
+			return
+		}
+		if handler, ok := a.metadataListeners[event.MetadataEventType()]; ok {
+			handleEvent(event.Type, func() { handler(ctx, event) })
+		} else {
+			fmt.Printf("Unrecognized message metadata event_type: %s\n", event.MetadataEventType())
+		}
+	case "link_shared":
+		var event SlackLinkSharedEvent
+		err = json.Unmarshal(envelope.Event, &event)
+		if err != nil {
+			Response(&SlackContext{Res: res}, http.StatusBadRequest, []byte("Invalid JSON format"), nil)
+			return
+		}
+		handleEvent(event.Type, func() { a.unfurlLinks(ctx, event) })
+	default:
+		fmt.Printf("Unrecognized event: %s\n", eventType.Type)
+	}
+	Response(&SlackContext{Res: res}, http.StatusOK, nil, nil)
+}
@@ -0,0 +1,366 @@
+package loafer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// SlackFile - Slack file
+type SlackFile struct {
+	ID                 string   `json:"id"`
+	Created            int64    `json:"created"`
+	Timestamp          int64    `json:"timestamp"`
+	Name               string   `json:"name"`
+	Title              string   `json:"title"`
+	Mimetype           string   `json:"mimetype"`
+	FileType           string   `json:"filetype"`
+	PrettyType         string   `json:"pretty_type"`
+	User               string   `json:"user"`
+	Mode               string   `json:"mode"`
+	Editable           bool     `json:"editable"`
+	Size               int64    `json:"size"`
+	IsExternal         bool     `json:"is_external"`
+	ExternalType       string   `json:"external_type"`
+	ExternalID         string   `json:"external_id"`
+	ExternalURL        string   `json:"external_url"`
+	IsPublic           bool     `json:"is_public"`
+	PublicURLShared    bool     `json:"public_url_shared"`
+	URLPrivate         string   `json:"url_private"`
+	URLPrivateDownload string   `json:"url_private_download"`
+	Permalink          string   `json:"permalink"`
+	PermalinkPublic    string   `json:"permalink_public"`
+	Channels           []string `json:"channels"`
+	Groups             []string `json:"groups"`
+	IMs                []string `json:"ims"`
+	CommentsCount      int      `json:"comments_count"`
+}
+
+// SlackPaging - Slack page based pagination
+type SlackPaging struct {
+	Count int `json:"count"`
+	Total int `json:"total"`
+	Page  int `json:"page"`
+	Pages int `json:"pages"`
+}
+
+// SlackFiles - Slack files.list and files.remote.list response
+type SlackFiles struct {
+	Files            []SlackFile           `json:"files"`
+	Paging           SlackPaging           `json:"paging"`
+	ResponseMetadata SlackResponseMetadata `json:"response_metadata"`
+}
+
+// SlackFilesListOptions - Slack files.list filters, fields left empty are not sent
+type SlackFilesListOptions struct {
+	Channel string   // Only files shared in this channel
+	User    string   // Only files created by this user
+	TSFrom  int64    // Only files created after this unix time
+	TSTo    int64    // Only files created before this unix time
+	Types   []string // Only files of these types, e.g. images, pdfs
+	Count   uint16   // Number of files per page
+	Page    uint16   // Page number, starting at 1
+}
+
+// SlackRemoteFile - Externally hosted file to add to or update in Slack
+type SlackRemoteFile struct {
+	ExternalID  string // Your unique identifier of the file
+	ExternalURL string // URL of the hosted file
+	Title       string // Title of the file
+	FileType    string // Type of the file, e.g. gdoc
+}
+
+// SlackFileUpload - A file to upload
+type SlackFileUpload struct {
+	Filename    string    // Name of the file
+	Title       string    // Title of the file, defaults to Filename
+	AltText     string    // Description of an image for screen readers
+	SnippetType string    // Syntax type of a snippet, e.g. python
+	Reader      io.Reader // File content, streamed to Slack
+	Size        int64     // Exact size of the content in bytes
+}
+
+// SlackUploadOptions - Where to share uploaded files
+type SlackUploadOptions struct {
+	Channels       []string // Channels to share the files to, files stay private when empty
+	ThreadTS       string   // Share as a reply in the thread of this message ts (single channel only)
+	InitialComment string   // Message text introducing the files
+}
+
+// SlackUploadURL - Slack files.getUploadURLExternal response
+type SlackUploadURL struct {
+	UploadURL string `json:"upload_url"`
+	FileID    string `json:"file_id"`
+}
+
+// slackCompletedFile - File reference of files.completeUploadExternal
+type slackCompletedFile struct {
+	ID    string `json:"id"`
+	Title string `json:"title,omitempty"`
+}
+
+// getUploadURL - Reserve an upload URL for a file
+func getUploadURL(file *SlackFileUpload, token string) (SlackUploadURL, error) {
+	var res SlackUploadURL
+	form := url.Values{}
+	form.Set("filename", file.Filename)
+	form.Set("length", strconv.FormatInt(file.Size, 10))
+	if len(file.AltText) > 0 {
+		form.Set("alt_txt", file.AltText)
+	}
+	if len(file.SnippetType) > 0 {
+		form.Set("snippet_type", file.SnippetType)
+	}
+	err := slackAPICall("files.getUploadURLExternal", form, token, &res)
+	return res, err
+}
+
+// streamUpload - Stream file content as a multipart form to an upload URL with the client's HTTP client and retries,
+// a rate limited upload is only retried when the reader can seek back
+func streamUpload(client *SlackAPIClient, uploadURL string, file *SlackFileUpload) error {
+	var head bytes.Buffer
+	mw := multipart.NewWriter(&head)
+	_, err := mw.CreateFormFile("file", file.Filename)
+	if err != nil {
+		return err
+	}
+	headLength := head.Len()
+	err = mw.Close()
+	if err != nil {
+		return err
+	}
+	tail := head.Bytes()[headLength:]
+	seeker, canSeek := file.Reader.(io.Seeker)
+	var start int64
+	if canSeek {
+		start, err = seeker.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return err
+		}
+	}
+	attempts := 0
+	status, text, err := sendSlackRequest(context.Background(), &client.opts, func() (*http.Request, error) {
+		if attempts > 0 {
+			if !canSeek {
+				return nil, fmt.Errorf("slack file upload of %s was rate limited and its reader cannot be rewound", file.Filename)
+			}
+			_, err := seeker.Seek(start, io.SeekStart)
+			if err != nil {
+				return nil, err
+			}
+		}
+		attempts++
+		body := io.MultiReader(bytes.NewReader(head.Bytes()[:headLength]), io.LimitReader(file.Reader, file.Size), bytes.NewReader(tail))
+		r, err := http.NewRequest("POST", uploadURL, body)
+		if err != nil {
+			return nil, err
+		}
+		r.ContentLength = int64(len(head.Bytes())) + file.Size
+		r.Header.Set("Content-Type", mw.FormDataContentType())
+		return r, nil
+	})
+	if err != nil {
+		return err
+	}
+	if status != http.StatusOK {
+		return fmt.Errorf("slack file upload of %s failed with status %d: %s", file.Filename, status, string(text))
+	}
+	return nil
+}
+
+// UploadFiles - Upload files with the external upload flow and return the created file IDs, opts can be nil
+func UploadFiles(files []SlackFileUpload, opts *SlackUploadOptions, token string) ([]string, error) {
+	var res struct {
+		Files []slackCompletedFile `json:"files"`
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("slack file upload: no files to upload")
+	}
+	client := InitializeSlackAPIClient(token, nil)
+	completed := []slackCompletedFile{}
+	for i := range files {
+		uploadURL, err := getUploadURL(&files[i], token)
+		if err != nil {
+			return nil, err
+		}
+		err = streamUpload(client, uploadURL.UploadURL, &files[i])
+		if err != nil {
+			return nil, err
+		}
+		completed = append(completed, slackCompletedFile{ID: uploadURL.FileID, Title: files[i].Title})
+	}
+	jsonFiles, err := json.Marshal(completed)
+	if err != nil {
+		return nil, err
+	}
+	form := url.Values{}
+	form.Set("files", string(jsonFiles))
+	if opts != nil {
+		if len(opts.Channels) > 0 {
+			form.Set("channels", strings.Join(opts.Channels, ","))
+		}
+		if len(opts.ThreadTS) > 0 {
+			form.Set("thread_ts", opts.ThreadTS)
+		}
+		if len(opts.InitialComment) > 0 {
+			form.Set("initial_comment", opts.InitialComment)
+		}
+	}
+	err = slackAPICall("files.completeUploadExternal", form, token, &res)
+	if err != nil {
+		return nil, err
+	}
+	ids := []string{}
+	for _, f := range res.Files {
+		ids = append(ids, f.ID)
+	}
+	return ids, nil
+}
+
+// slackFileCall - Calls a files.* API that responds with a file
+func slackFileCall(method string, form url.Values, token string) (SlackFile, error) {
+	var res struct {
+		File SlackFile `json:"file"`
+	}
+	err := slackAPICall(method, form, token, &res)
+	return res.File, err
+}
+
+// remoteFileForm - Identify a remote file by Slack file ID or by external ID
+func remoteFileForm(fileID string, externalID string) url.Values {
+	form := url.Values{}
+	if len(fileID) > 0 {
+		form.Set("file", fileID)
+	}
+	if len(externalID) > 0 {
+		form.Set("external_id", externalID)
+	}
+	return form
+}
+
+// setRemoteFile - Set the remote file fields onto form
+func setRemoteFile(form url.Values, remote SlackRemoteFile) {
+	if len(remote.ExternalURL) > 0 {
+		form.Set("external_url", remote.ExternalURL)
+	}
+	if len(remote.Title) > 0 {
+		form.Set("title", remote.Title)
+	}
+	if len(remote.FileType) > 0 {
+		form.Set("filetype", remote.FileType)
+	}
+}
+
+// ListFiles - List a page of files, opts can be nil
+func ListFiles(opts *SlackFilesListOptions, token string) (SlackFiles, error) {
+	var res SlackFiles
+	form := url.Values{}
+	if opts != nil {
+		if len(opts.Channel) > 0 {
+			form.Set("channel", opts.Channel)
+		}
+		if len(opts.User) > 0 {
+			form.Set("user", opts.User)
+		}
+		if opts.TSFrom > 0 {
+			form.Set("ts_from", strconv.FormatInt(opts.TSFrom, 10))
+		}
+		if opts.TSTo > 0 {
+			form.Set("ts_to", strconv.FormatInt(opts.TSTo, 10))
+		}
+		if len(opts.Types) > 0 {
+			form.Set("types", strings.Join(opts.Types, ","))
+		}
+		if opts.Count > 0 {
+			form.Set("count", strconv.Itoa(int(opts.Count)))
+		}
+		if opts.Page > 0 {
+			form.Set("page", strconv.Itoa(int(opts.Page)))
+		}
+	}
+	err := slackAPICall("files.list", form, token, &res)
+	return res, err
+}
+
+// GetFileInfo - Get information about a file
+func GetFileInfo(fileID string, token string) (SlackFile, error) {
+	form := url.Values{}
+	form.Set("file", fileID)
+	return slackFileCall("files.info", form, token)
+}
+
+// DeleteFile - Delete a file
+func DeleteFile(fileID string, token string) error {
+	form := url.Values{}
+	form.Set("file", fileID)
+	return slackAPICall("files.delete", form, token, nil)
+}
+
+// ShareFilePublicURL - Enable public sharing of a file, requires a user token
+func ShareFilePublicURL(fileID string, token string) (SlackFile, error) {
+	form := url.Values{}
+	form.Set("file", fileID)
+	return slackFileCall("files.sharedPublicURL", form, token)
+}
+
+// AddRemoteFile - Add an externally hosted file to Slack
+func AddRemoteFile(remote SlackRemoteFile, token string) (SlackFile, error) {
+	form := remoteFileForm("", remote.ExternalID)
+	setRemoteFile(form, remote)
+	return slackFileCall("files.remote.add", form, token)
+}
+
+// GetRemoteFileInfo - Get a remote file by Slack file ID or external ID
+func GetRemoteFileInfo(fileID string, externalID string, token string) (SlackFile, error) {
+	return slackFileCall("files.remote.info", remoteFileForm(fileID, externalID), token)
+}
+
+// ListRemoteFiles - List a page of remote files, channel and cursor can be empty
+func ListRemoteFiles(channel string, cursor string, token string) (SlackFiles, error) {
+	var res SlackFiles
+	form := url.Values{}
+	if len(channel) > 0 {
+		form.Set("channel", channel)
+	}
+	if len(cursor) > 0 {
+		form.Set("cursor", cursor)
+	}
+	err := slackAPICall("files.remote.list", form, token, &res)
+	return res, err
+}
+
+// UpdateRemoteFile - Update a remote file identified by Slack file ID or remote.ExternalID
+func UpdateRemoteFile(fileID string, remote SlackRemoteFile, token string) (SlackFile, error) {
+	form := remoteFileForm(fileID, remote.ExternalID)
+	setRemoteFile(form, remote)
+	return slackFileCall("files.remote.update", form, token)
+}
+
+// RemoveRemoteFile - Remove a remote file by Slack file ID or external ID
+func RemoveRemoteFile(fileID string, externalID string, token string) error {
+	return slackAPICall("files.remote.remove", remoteFileForm(fileID, externalID), token, nil)
+}
+
+// ShareRemoteFile - Share a remote file into channels
+func ShareRemoteFile(fileID string, externalID string, channels []string, token string) (SlackFile, error) {
+	form := remoteFileForm(fileID, externalID)
+	form.Set("channels", strings.Join(channels, ","))
+	return slackFileCall("files.remote.share", form, token)
+}
+
+// FileUpload - Upload a text file, filetype is sent as the snippet type
+func FileUpload(channels []string, filename string, content string, filetype string, token string) error {
+	_, err := UploadFiles([]SlackFileUpload{{
+		Filename:    filename,
+		SnippetType: filetype,
+		Reader:      strings.NewReader(content),
+		Size:        int64(len(content))}}, &SlackUploadOptions{Channels: channels}, token)
+	return err
+}
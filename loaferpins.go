@@ -0,0 +1,24 @@
+package loafer
+
+import (
+	"net/url"
+)
+
+// AddPin - Pin a message to a channel
+func AddPin(channel string, ts string, token string) error {
+	return slackAPICall("pins.add", messageItemForm(channel, ts), token, nil)
+}
+
+// RemovePin - Unpin a message from a channel
+func RemovePin(channel string, ts string, token string) error {
+	return slackAPICall("pins.remove", messageItemForm(channel, ts), token, nil)
+}
+
+// ListPins - List the items pinned to a channel
+func ListPins(channel string, token string) ([]SlackItem, error) {
+	var res SlackItems
+	form := url.Values{}
+	form.Set("channel", channel)
+	err := slackAPICall("pins.list", form, token, &res)
+	return res.Items, err
+}
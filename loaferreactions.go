@@ -0,0 +1,75 @@
+package loafer
+
+import (
+	"net/url"
+)
+
+// SlackItem - Slack item (message or file) of reactions.list and pins.list
+type SlackItem struct {
+	Type      string        `json:"type"`
+	Channel   string        `json:"channel"`
+	Message   *SlackMessage `json:"message"`
+	File      *SlackFile    `json:"file"`
+	Created   int64         `json:"created"`
+	CreatedBy string        `json:"created_by"`
+}
+
+// SlackItems - Slack page of items
+type SlackItems struct {
+	Items            []SlackItem           `json:"items"`
+	ResponseMetadata SlackResponseMetadata `json:"response_metadata"`
+}
+
+// messageItemForm - Identify a message by channel and ts
+func messageItemForm(channel string, ts string) url.Values {
+	form := url.Values{}
+	form.Set("channel", channel)
+	form.Set("timestamp", ts)
+	return form
+}
+
+// slackItemsCall - Calls a *.list API that responds with a page of items
+func slackItemsCall(method string, form url.Values, cursor string, token string) (SlackItems, error) {
+	var res SlackItems
+	if len(cursor) > 0 {
+		form.Set("cursor", cursor)
+	}
+	err := slackAPICall(method, form, token, &res)
+	return res, err
+}
+
+// AddReaction - Add an emoji reaction to a message, name is the emoji without colons
+func AddReaction(channel string, ts string, name string, token string) error {
+	form := messageItemForm(channel, ts)
+	form.Set("name", name)
+	return slackAPICall("reactions.add", form, token, nil)
+}
+
+// RemoveReaction - Remove an emoji reaction from a message
+func RemoveReaction(channel string, ts string, name string, token string) error {
+	form := messageItemForm(channel, ts)
+	form.Set("name", name)
+	return slackAPICall("reactions.remove", form, token, nil)
+}
+
+// GetReactions - Get the reactions of a message
+func GetReactions(channel string, ts string, token string) ([]SlackReaction, error) {
+	var res SlackItem
+	form := messageItemForm(channel, ts)
+	form.Set("full", "true")
+	err := slackAPICall("reactions.get", form, token, &res)
+	if err != nil || res.Message == nil {
+		return nil, err
+	}
+	return res.Message.Reactions, nil
+}
+
+// ListReactions - List a page of items reacted to by user, user and cursor can be empty
+func ListReactions(user string, cursor string, token string) (SlackItems, error) {
+	form := url.Values{}
+	form.Set("full", "true")
+	if len(user) > 0 {
+		form.Set("user", user)
+	}
+	return slackItemsCall("reactions.list", form, cursor, token)
+}
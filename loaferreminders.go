@@ -0,0 +1,55 @@
+package loafer
+
+import (
+	"net/url"
+)
+
+// SlackReminder - Slack reminder
+type SlackReminder struct {
+	ID         string `json:"id"`
+	Creator    string `json:"creator"`
+	User       string `json:"user"`
+	Text       string `json:"text"`
+	Recurring  bool   `json:"recurring"`
+	Time       int64  `json:"time"`
+	CompleteTS int64  `json:"complete_ts"`
+}
+
+// AddReminder - Add a reminder, when is a unix time, a delay in seconds or natural language ("in 15 minutes"),
+// user can be empty for the token's user. Reminders require a user token
+func AddReminder(text string, when string, user string, token string) (SlackReminder, error) {
+	var res struct {
+		Reminder SlackReminder `json:"reminder"`
+	}
+	form := url.Values{}
+	form.Set("text", text)
+	form.Set("time", when)
+	if len(user) > 0 {
+		form.Set("user", user)
+	}
+	err := slackAPICall("reminders.add", form, token, &res)
+	return res.Reminder, err
+}
+
+// ListReminders - List the reminders created by or for the token's user
+func ListReminders(token string) ([]SlackReminder, error) {
+	var res struct {
+		Reminders []SlackReminder `json:"reminders"`
+	}
+	err := slackAPICall("reminders.list", nil, token, &res)
+	return res.Reminders, err
+}
+
+// CompleteReminder - Mark a reminder as complete
+func CompleteReminder(reminderID string, token string) error {
+	form := url.Values{}
+	form.Set("reminder", reminderID)
+	return slackAPICall("reminders.complete", form, token, nil)
+}
+
+// DeleteReminder - Delete a reminder
+func DeleteReminder(reminderID string, token string) error {
+	form := url.Values{}
+	form.Set("reminder", reminderID)
+	return slackAPICall("reminders.delete", form, token, nil)
+}
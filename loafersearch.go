@@ -0,0 +1,101 @@
+package loafer
+
+import (
+	"net/url"
+	"strconv"
+)
+
+// SlackSearchOptions - Slack search.* options, fields left empty are not sent
+type SlackSearchOptions struct {
+	Sort      string // score or timestamp
+	SortDir   string // asc or desc
+	Highlight bool   // Wrap matching terms in highlight markers
+	Count     uint16 // Number of matches per page
+	Page      uint16 // Page number, starting at 1
+}
+
+// SlackSearchChannel - Channel of a search match
+type SlackSearchChannel struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	IsPrivate bool   `json:"is_private"`
+	IsMPIM    bool   `json:"is_mpim"`
+	IsIM      bool   `json:"is_im"`
+}
+
+// SlackMessageMatch - Message matching a search
+type SlackMessageMatch struct {
+	IID       string             `json:"iid"`
+	Team      string             `json:"team"`
+	Channel   SlackSearchChannel `json:"channel"`
+	Type      string             `json:"type"`
+	User      string             `json:"user"`
+	Username  string             `json:"username"`
+	TS        string             `json:"ts"`
+	Text      string             `json:"text"`
+	Permalink string             `json:"permalink"`
+}
+
+// SlackMessageMatches - Page of messages matching a search
+type SlackMessageMatches struct {
+	Total   int                 `json:"total"`
+	Paging  SlackPaging         `json:"paging"`
+	Matches []SlackMessageMatch `json:"matches"`
+}
+
+// SlackFileMatches - Page of files matching a search
+type SlackFileMatches struct {
+	Total   int         `json:"total"`
+	Paging  SlackPaging `json:"paging"`
+	Matches []SlackFile `json:"matches"`
+}
+
+// SlackSearchResult - Slack search.* response
+type SlackSearchResult struct {
+	Query    string              `json:"query"`
+	Messages SlackMessageMatches `json:"messages"`
+	Files    SlackFileMatches    `json:"files"`
+}
+
+// slackSearchCall - Calls a search.* API
+func slackSearchCall(method string, query string, opts *SlackSearchOptions, userToken string) (SlackSearchResult, error) {
+	var res SlackSearchResult
+	form := url.Values{}
+	form.Set("query", query)
+	if opts != nil {
+		if len(opts.Sort) > 0 {
+			form.Set("sort", opts.Sort)
+		}
+		if len(opts.SortDir) > 0 {
+			form.Set("sort_dir", opts.SortDir)
+		}
+		if opts.Highlight {
+			form.Set("highlight", "true")
+		}
+		if opts.Count > 0 {
+			form.Set("count", strconv.Itoa(int(opts.Count)))
+		}
+		if opts.Page > 0 {
+			form.Set("page", strconv.Itoa(int(opts.Page)))
+		}
+	}
+	err := slackAPICall(method, form, userToken, &res)
+	return res, err
+}
+
+// SearchMessages - Search messages on behalf of a user, requires a user token such as SlackContext.UserToken
+func SearchMessages(query string, opts *SlackSearchOptions, userToken string) (SlackMessageMatches, error) {
+	res, err := slackSearchCall("search.messages", query, opts, userToken)
+	return res.Messages, err
+}
+
+// SearchFiles - Search files on behalf of a user, requires a user token such as SlackContext.UserToken
+func SearchFiles(query string, opts *SlackSearchOptions, userToken string) (SlackFileMatches, error) {
+	res, err := slackSearchCall("search.files", query, opts, userToken)
+	return res.Files, err
+}
+
+// SearchAll - Search messages and files on behalf of a user, requires a user token such as SlackContext.UserToken
+func SearchAll(query string, opts *SlackSearchOptions, userToken string) (SlackSearchResult, error) {
+	return slackSearchCall("search.all", query, opts, userToken)
+}
@@ -5,37 +5,51 @@ type ISlackBlockKitUI interface{}
 
 // SlackBlockText - Slack Text
 type SlackBlockText struct {
-	Type  string `json:"type,omitempty"`
-	Text  string `json:"text,omitempty"`
-	Emoji *bool  `json:"emoji,omitempty"`
+	Type     string `json:"type,omitempty"`
+	Text     string `json:"text,omitempty"`
+	Emoji    *bool  `json:"emoji,omitempty"`
+	Verbatim *bool  `json:"verbatim,omitempty"`
 }
 
 // SlackDivider - Slack divider
 type SlackDivider struct {
-	Type string `json:"type"`
+	Type    string `json:"type"`
+	BlockID string `json:"block_id,omitempty"`
 }
 
-// SlackBlockAccessory - Slack Accessory
+// SlackBlockAccessory - Slack Accessory, the untyped element of SlackInputElement, SlackModalSelect and SlackBlockUserSelect
 type SlackBlockAccessory struct {
-	Type                 string             `json:"type,omitempty"`
-	Title                *SlackBlockText    `json:"title,omitempty"`
-	AltText              string             `json:"alt_text,omitempty"`
-	IsMultiline          bool               `json:"multiline,omitempty"`
-	MaxLength            uint16             `json:"max_length,omitempty"`
-	Placeholder          *SlackBlockText    `json:"placeholder,omitempty"`
-	ImageURL             string             `json:"image_url,omitempty"`
-	ActionID             string             `json:"action_id,omitempty"`
-	Options              []SlackInputOption `json:"options,omitempty"`
-	InitialDate          string             `json:"initial_date,omitempty"`
-	InitialTime          string             `json:"initial_time,omitempty"`
-	InitialOption        *SlackInputOption  `json:"initial_option,omitempty"`
-	InitialOptions       []SlackInputOption `json:"initial_options,omitempty"`
-	InitialConversations []string           `json:"initial_conversations,omitempty"`
-	InitialUser          string             `json:"initial_user,omitempty"`
-	InitialUsers         []string           `json:"initial_users,omitempty"`
-}
-
-// SlackBlockTextSection - Slack Text section
+	Type                         string                     `json:"type,omitempty"`
+	Title                        *SlackBlockText            `json:"title,omitempty"`
+	AltText                      string                     `json:"alt_text,omitempty"`
+	IsMultiline                  bool                       `json:"multiline,omitempty"`
+	MinLength                    uint16                     `json:"min_length,omitempty"`
+	MaxLength                    uint16                     `json:"max_length,omitempty"`
+	Placeholder                  *SlackBlockText            `json:"placeholder,omitempty"`
+	ImageURL                     string                     `json:"image_url,omitempty"`
+	ActionID                     string                     `json:"action_id,omitempty"`
+	Options                      []SlackInputOption         `json:"options,omitempty"`
+	OptionGroups                 []SlackOptionGroup         `json:"option_groups,omitempty"`
+	InitialValue                 string                     `json:"initial_value,omitempty"`
+	InitialDate                  string                     `json:"initial_date,omitempty"`
+	InitialTime                  string                     `json:"initial_time,omitempty"`
+	Timezone                     string                     `json:"timezone,omitempty"`
+	InitialOption                *SlackInputOption          `json:"initial_option,omitempty"`
+	InitialOptions               []SlackInputOption         `json:"initial_options,omitempty"`
+	InitialConversation          string                     `json:"initial_conversation,omitempty"`
+	InitialConversations         []string                   `json:"initial_conversations,omitempty"`
+	DefaultToCurrentConversation bool                       `json:"default_to_current_conversation,omitempty"`
+	Filter                       *SlackConversationFilter   `json:"filter,omitempty"`
+	InitialUser                  string                     `json:"initial_user,omitempty"`
+	InitialUsers                 []string                   `json:"initial_users,omitempty"`
+	MaxSelectedItems             uint16                     `json:"max_selected_items,omitempty"`
+	ResponseURLEnabled           bool                       `json:"response_url_enabled,omitempty"`
+	DispatchActionConfig         *SlackDispatchActionConfig `json:"dispatch_action_config,omitempty"`
+	FocusOnLoad                  bool                       `json:"focus_on_load,omitempty"`
+	Confirm                      *SlackConfirmDialog        `json:"confirm,omitempty"`
+}
+
+// SlackBlockTextSection - Slack Text section, see SlackSectionBlock for sections with fields or an accessory
 type SlackBlockTextSection struct {
 	Type string          `json:"type,omitempty"`
 	Text *SlackBlockText `json:"text,omitempty"`
@@ -64,27 +78,34 @@ type SlackModalSelect struct {
 
 // SlackBlockButton - Slack Button action
 type SlackBlockButton struct {
-	Type     string          `json:"type,omitempty"`
-	Text     *SlackBlockText `json:"text,omitempty"`
-	Value    string          `json:"value,omitempty"`
-	ActionID string          `json:"action_id,omitempty"`
+	Type               string              `json:"type,omitempty"`
+	Text               *SlackBlockText     `json:"text,omitempty"`
+	Value              string              `json:"value,omitempty"`
+	ActionID           string              `json:"action_id,omitempty"`
+	Style              string              `json:"style,omitempty"`
+	URL                string              `json:"url,omitempty"`
+	Confirm            *SlackConfirmDialog `json:"confirm,omitempty"`
+	AccessibilityLabel string              `json:"accessibility_label,omitempty"`
 }
 
 // SlackInputOption - Slack Select option
 type SlackInputOption struct {
-	Text  *SlackBlockText `json:"text,omitempty"`
-	Value string          `json:"value,omitempty"`
+	Text        *SlackBlockText `json:"text,omitempty"`
+	Value       string          `json:"value,omitempty"`
+	Description *SlackBlockText `json:"description,omitempty"`
+	URL         string          `json:"url,omitempty"`
 }
 
 // SlackBlockActions - Slack Actions
 type SlackBlockActions struct {
-	Type     string           `json:"type,omitempty"`
-	Elements ISlackBlockKitUI `json:"elements,omitempty"`
+	Type     string          `json:"type,omitempty"`
+	BlockID  string          `json:"block_id,omitempty"`
+	Elements ActionsElements `json:"elements,omitempty"`
 }
 
 // SlackUI - Slack UI
 type SlackUI struct {
-	Blocks ISlackBlockKitUI `json:"blocks,omitempty"`
+	Blocks Blocks `json:"blocks,omitempty"`
 }
 
 // SlackInteractionUser - Slack Interaction User
@@ -147,7 +168,7 @@ type SlackInteractionView struct {
 	ID                 string                      `json:"id,omitempty"`
 	TeamID             string                      `json:"team_id,omitempty"`
 	Type               string                      `json:"type,omitempty"`
-	Blocks             ISlackBlockKitUI            `json:"blocks,omitempty"`
+	Blocks             Blocks                      `json:"blocks,omitempty"`
 	PrivateMetadata    string                      `json:"private_metadata,omitempty"`
 	CallbackID         string                      `json:"callback_id,omitempty"`
 	State              map[string]ISlackBlockKitUI `json:"state,omitempty"`
@@ -167,14 +188,25 @@ type SlackInteractionView struct {
 
 // SlackModal - Slack Modal
 type SlackModal struct {
-	Type            string           `json:"type,omitempty"`
-	Title           *SlackBlockText  `json:"title,omitempty"`
-	Submit          *SlackBlockText  `json:"submit,omitempty"`
-	Close           *SlackBlockText  `json:"close,omitempty"`
-	Blocks          ISlackBlockKitUI `json:"blocks,omitempty"`
-	CallbackID      string           `json:"callback_id,omitempty"`
-	NotifyOnClose   bool             `json:"notify_on_close,omitempty"`
-	PrivateMetadata string           `json:"private_metadata,omitempty"`
+	Type            string          `json:"type,omitempty"`
+	Title           *SlackBlockText `json:"title,omitempty"`
+	Submit          *SlackBlockText `json:"submit,omitempty"`
+	Close           *SlackBlockText `json:"close,omitempty"`
+	Blocks          Blocks          `json:"blocks,omitempty"`
+	CallbackID      string          `json:"callback_id,omitempty"`
+	NotifyOnClose   bool            `json:"notify_on_close,omitempty"`
+	ClearOnClose    bool            `json:"clear_on_close,omitempty"`
+	PrivateMetadata string          `json:"private_metadata,omitempty"`
+	ExternalID      string          `json:"external_id,omitempty"`
+}
+
+// SlackHomeView - Slack App Home tab
+type SlackHomeView struct {
+	Type            string `json:"type,omitempty"`
+	Blocks          Blocks `json:"blocks,omitempty"`
+	CallbackID      string `json:"callback_id,omitempty"`
+	PrivateMetadata string `json:"private_metadata,omitempty"`
+	ExternalID      string `json:"external_id,omitempty"`
 }
 
 // SlackInputElement - Slack Modal Plain text input
@@ -201,8 +233,8 @@ func MakeSlackButton(text string, value string, actionID string) SlackBlockButto
 }
 
 // MakeSlackTextSection - Make a text section (markdown)
-func MakeSlackTextSection(text string) SlackBlockTextSection {
-	return SlackBlockTextSection{
+func MakeSlackTextSection(text string) SlackSectionBlock {
+	return SlackSectionBlock{
 		Type: "section",
 		Text: &SlackBlockText{
 			Type: "mrkdwn",
@@ -210,18 +242,18 @@ func MakeSlackTextSection(text string) SlackBlockTextSection {
 }
 
 // MakeSlackTextFieldsSection - Make a text section (markdown)
-func MakeSlackTextFieldsSection(texts []string) SlackBlockTextFields {
+func MakeSlackTextFieldsSection(texts []string) SlackSectionBlock {
 	textFields := []SlackBlockText{}
 	for _, t := range texts {
 		textFields = append(textFields, SlackBlockText{Type: "mrkdwn", Text: t})
 	}
-	return SlackBlockTextFields{
+	return SlackSectionBlock{
 		Type:   "section",
 		Fields: textFields}
 }
 
 // MakeSlackModal - Make a slack button
-func MakeSlackModal(title string, callbackID string, blocks ISlackBlockKitUI, submitText string, closeText string, notifyOnClose bool) SlackModal {
+func MakeSlackModal(title string, callbackID string, blocks []Block, submitText string, closeText string, notifyOnClose bool) SlackModal {
 	return SlackModal{
 		Type: "modal",
 		Title: &SlackBlockText{
@@ -238,8 +270,16 @@ func MakeSlackModal(title string, callbackID string, blocks ISlackBlockKitUI, su
 		NotifyOnClose: notifyOnClose}
 }
 
+// MakeSlackHomeView - Make a slack App Home view
+func MakeSlackHomeView(callbackID string, blocks []Block) SlackHomeView {
+	return SlackHomeView{
+		Type:       "home",
+		Blocks:     blocks,
+		CallbackID: callbackID}
+}
+
 // MakeSlackActions - Make slack actions
-func MakeSlackActions(actions ISlackBlockKitUI) SlackBlockActions {
+func MakeSlackActions(actions []ActionsElement) SlackBlockActions {
 	return SlackBlockActions{
 		Type:     "actions",
 		Elements: actions}
@@ -401,12 +441,10 @@ func MakeSlackModalTimePickerInput(label string, placeholder string, initialTime
 }
 
 // MakeSlackHeader - Make a slack header section
-func MakeSlackHeader(text string) SlackBlockTextSection {
-	return SlackBlockTextSection{
+func MakeSlackHeader(text string) SlackHeaderBlock {
+	return SlackHeaderBlock{
 		Type: "header",
-		Text: &SlackBlockText{
-			Type: "mrkdwn",
-			Text: text}}
+		Text: makePlainText(text)}
 }
 
 // MakeSlackDivider -Make a slack divider
@@ -415,23 +453,24 @@ func MakeSlackDivider() SlackDivider {
 		Type: "divider"}
 }
 
-// MakeSlackContext - Make a slack context
-func MakeSlackContext(text string) SlackBlockActions {
-	return SlackBlockActions{
+// MakeSlackContext - Make a slack context (plain text), see MakeSlackMarkdownContext for markdown
+func MakeSlackContext(text string) SlackContextBlock {
+	return SlackContextBlock{
 		Type: "context",
-		Elements: []ISlackBlockKitUI{
+		Elements: []ContextElement{
 			SlackBlockText{
-				Type: "mrkdwn",
+				Type: "plain_text",
 				Text: text}}}
 }
 
-// MakeSlackImage - Make a slack image section
-func MakeSlackImage(title string, imageURL string, altText string) SlackBlockAccessory {
-	return SlackBlockAccessory{
-		Type: "image",
-		Title: &SlackBlockText{
-			Type: "mrkdwn",
-			Text: title},
+// MakeSlackImage - Make a slack image block, title can be empty
+func MakeSlackImage(title string, imageURL string, altText string) SlackImageBlock {
+	image := SlackImageBlock{
+		Type:     "image",
 		ImageURL: imageURL,
 		AltText:  altText}
+	if len(title) > 0 {
+		image.Title = makePlainText(title)
+	}
+	return image
 }
@@ -0,0 +1,177 @@
+package loafer
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// SlackUserGroupPrefs - Slack user group default channels
+type SlackUserGroupPrefs struct {
+	Channels []string `json:"channels"`
+	Groups   []string `json:"groups"`
+}
+
+// SlackUserGroup - Slack user group
+type SlackUserGroup struct {
+	ID          string              `json:"id"`
+	TeamID      string              `json:"team_id"`
+	IsUsergroup bool                `json:"is_usergroup"`
+	Name        string              `json:"name"`
+	Description string              `json:"description"`
+	Handle      string              `json:"handle"`
+	IsExternal  bool                `json:"is_external"`
+	DateCreate  int64               `json:"date_create"`
+	DateUpdate  int64               `json:"date_update"`
+	DateDelete  int64               `json:"date_delete"`
+	AutoType    string              `json:"auto_type"`
+	CreatedBy   string              `json:"created_by"`
+	UpdatedBy   string              `json:"updated_by"`
+	DeletedBy   string              `json:"deleted_by"`
+	Prefs       SlackUserGroupPrefs `json:"prefs"`
+	Users       []string            `json:"users"`
+}
+
+// slackUserGroupCall - Calls a usergroups.* API that responds with a user group
+func slackUserGroupCall(method string, form url.Values, token string) (SlackUserGroup, error) {
+	var res struct {
+		Usergroup SlackUserGroup `json:"usergroup"`
+	}
+	err := slackAPICall(method, form, token, &res)
+	return res.Usergroup, err
+}
+
+// setUserGroup - Set the user group fields onto form, empty fields are not sent
+func setUserGroup(form url.Values, name string, handle string, description string, channels []string) {
+	if len(name) > 0 {
+		form.Set("name", name)
+	}
+	if len(handle) > 0 {
+		form.Set("handle", handle)
+	}
+	if len(description) > 0 {
+		form.Set("description", description)
+	}
+	if len(channels) > 0 {
+		form.Set("channels", strings.Join(channels, ","))
+	}
+}
+
+// CreateUserGroup - Create a user group, handle, description and channels can be empty
+func CreateUserGroup(name string, handle string, description string, channels []string, token string) (SlackUserGroup, error) {
+	form := url.Values{}
+	setUserGroup(form, name, handle, description, channels)
+	return slackUserGroupCall("usergroups.create", form, token)
+}
+
+// ListUserGroups - List the user groups of the workspace
+func ListUserGroups(includeUsers bool, includeDisabled bool, token string) ([]SlackUserGroup, error) {
+	var res struct {
+		Usergroups []SlackUserGroup `json:"usergroups"`
+	}
+	form := url.Values{}
+	form.Set("include_users", strconv.FormatBool(includeUsers))
+	form.Set("include_disabled", strconv.FormatBool(includeDisabled))
+	err := slackAPICall("usergroups.list", form, token, &res)
+	return res.Usergroups, err
+}
+
+// UpdateUserGroup - Update a user group, empty fields are left unchanged
+func UpdateUserGroup(groupID string, name string, handle string, description string, channels []string, token string) (SlackUserGroup, error) {
+	form := url.Values{}
+	form.Set("usergroup", groupID)
+	setUserGroup(form, name, handle, description, channels)
+	return slackUserGroupCall("usergroups.update", form, token)
+}
+
+// DisableUserGroup - Disable a user group
+func DisableUserGroup(groupID string, token string) (SlackUserGroup, error) {
+	form := url.Values{}
+	form.Set("usergroup", groupID)
+	return slackUserGroupCall("usergroups.disable", form, token)
+}
+
+// EnableUserGroup - Enable a disabled user group
+func EnableUserGroup(groupID string, token string) (SlackUserGroup, error) {
+	form := url.Values{}
+	form.Set("usergroup", groupID)
+	return slackUserGroupCall("usergroups.enable", form, token)
+}
+
+// ListUserGroupMembers - List the user ids of a user group
+func ListUserGroupMembers(groupID string, token string) ([]string, error) {
+	var res struct {
+		Users []string `json:"users"`
+	}
+	form := url.Values{}
+	form.Set("usergroup", groupID)
+	err := slackAPICall("usergroups.users.list", form, token, &res)
+	return res.Users, err
+}
+
+// UpdateUserGroupMembers - Replace the members of a user group
+func UpdateUserGroupMembers(groupID string, users []string, token string) (SlackUserGroup, error) {
+	form := url.Values{}
+	form.Set("usergroup", groupID)
+	form.Set("users", strings.Join(users, ","))
+	return slackUserGroupCall("usergroups.users.update", form, token)
+}
+
+// uniqueMembers - Members without duplicates, in their first order
+func uniqueMembers(users []string) []string {
+	members := []string{}
+	seen := make(map[string]bool)
+	for _, u := range users {
+		if !seen[u] {
+			members = append(members, u)
+			seen[u] = true
+		}
+	}
+	return members
+}
+
+// diffMembers - Members of desired missing from current, and of current missing from desired
+func diffMembers(current []string, desired []string) ([]string, []string) {
+	added := []string{}
+	removed := []string{}
+	currentSet := make(map[string]bool)
+	desiredSet := make(map[string]bool)
+	for _, u := range current {
+		currentSet[u] = true
+	}
+	for _, u := range desired {
+		if !desiredSet[u] && !currentSet[u] {
+			added = append(added, u)
+		}
+		desiredSet[u] = true
+	}
+	for _, u := range current {
+		if !desiredSet[u] {
+			removed = append(removed, u)
+		}
+	}
+	return added, removed
+}
+
+// SyncUserGroupMembers - Make desired the members of a user group, only updating Slack when membership changed.
+// Slack does not allow a user group without members, an empty desired is an error, use DisableUserGroup instead
+func SyncUserGroupMembers(groupID string, desired []string, token string) (added []string, removed []string, err error) {
+	members := uniqueMembers(desired)
+	if len(members) == 0 {
+		return nil, nil, fmt.Errorf("slack user group sync: %s cannot have no members, disable it instead", groupID)
+	}
+	current, err := ListUserGroupMembers(groupID, token)
+	if err != nil {
+		return nil, nil, err
+	}
+	added, removed = diffMembers(current, members)
+	if len(added) == 0 && len(removed) == 0 {
+		return added, removed, nil
+	}
+	_, err = UpdateUserGroupMembers(groupID, members, token)
+	if err != nil {
+		return nil, nil, err
+	}
+	return added, removed, nil
+}
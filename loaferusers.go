@@ -0,0 +1,302 @@
+package loafer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// SlackUsersQuery - Slack User query
+type SlackUsersQuery struct {
+	Ok    bool      `json:"ok"`
+	User  SlackUser `json:"user"`
+	Error string    `json:"error"`
+}
+
+// SlackUserProfile - Slack User profile
+type SlackUserProfile struct {
+	AvatarHash            string `json:"avatar_hash"`
+	StatusText            string `json:"status_text"`
+	StatusEmoji           string `json:"status_emoji"`
+	StatusExpiration      int64  `json:"status_expiration"`
+	RealName              string `json:"real_name"`
+	DisplayName           string `json:"display_name"`
+	RealNameNormalized    string `json:"real_name_normalized"`
+	DisplayNameNormalized string `json:"display_name_normalized"`
+	FirstName             string `json:"first_name"`
+	LastName              string `json:"last_name"`
+	Title                 string `json:"title"`
+	Phone                 string `json:"phone"`
+	Email                 string `json:"email"`
+	Image24               string `json:"image_24"`
+	Image32               string `json:"image_32"`
+	Image48               string `json:"image_48"`
+	Image72               string `json:"image_72"`
+	Image192              string `json:"image_192"`
+	Image512              string `json:"image_512"`
+	Team                  string `json:"team"`
+}
+
+// SlackUser - Slack User
+type SlackUser struct {
+	ID                string           `json:"id"`
+	TeamID            string           `json:"team_id"`
+	Name              string           `json:"name"`
+	Deleted           bool             `json:"deleted"`
+	Color             string           `json:"color"`
+	RealName          string           `json:"real_name"`
+	TZ                string           `json:"tz"`
+	TZLabel           string           `json:"tz_label"`
+	TZOffset          int32            `json:"tz_offset"`
+	Profile           SlackUserProfile `json:"profile"`
+	IsAdmin           bool             `json:"is_admin"`
+	IsOwner           bool             `json:"is_owner"`
+	IsPrimaryOwner    bool             `json:"is_primary_owner"`
+	IsRestricted      bool             `json:"is_restricted"`
+	IsUltraRestricted bool             `json:"is_ultra_restricted"`
+	IsBot             bool             `json:"is_bot"`
+	Updated           uint32           `json:"updated"`
+	IsAppUser         bool             `json:"is_app_user"`
+	Has2FA            bool             `json:"has_2fa"`
+}
+
+// SlackUsers - Slack users.list response
+type SlackUsers struct {
+	Members          []SlackUser           `json:"members"`
+	ResponseMetadata SlackResponseMetadata `json:"response_metadata"`
+}
+
+// SlackPresence - Slack users.getPresence response
+type SlackPresence struct {
+	Presence        string `json:"presence"`
+	Online          bool   `json:"online"`
+	AutoAway        bool   `json:"auto_away"`
+	ManualAway      bool   `json:"manual_away"`
+	ConnectionCount int    `json:"connection_count"`
+	LastActivity    int64  `json:"last_activity"`
+}
+
+// slackUserCall - Calls Slack users.info or users.lookupByEmail API
+func slackUserCall(method string, params url.Values, token string) (SlackUser, error) {
+	var userQuery SlackUsersQuery
+	err := InitializeSlackAPIClient(token, nil).request(context.Background(), method, params).do(http.MethodGet, &userQuery)
+	return userQuery.User, err
+}
+
+// FindUserByEmail - Finding slack user by email
+func FindUserByEmail(email string, token string) (SlackUser, error) {
+	params := url.Values{}
+	params.Set("email", email)
+	return slackUserCall("users.lookupByEmail", params, token)
+}
+
+// FindUserByID - Finding slack user by id
+func FindUserByID(id string, token string) (SlackUser, error) {
+	params := url.Values{}
+	params.Set("user", id)
+	return slackUserCall("users.info", params, token)
+}
+
+// ListUsers - List a page of workspace members, cursor can be empty and limit 0 for Slack's default
+func ListUsers(cursor string, limit uint16, token string) (SlackUsers, error) {
+	var res SlackUsers
+	form := url.Values{}
+	if len(cursor) > 0 {
+		form.Set("cursor", cursor)
+	}
+	if limit > 0 {
+		form.Set("limit", strconv.Itoa(int(limit)))
+	}
+	err := slackAPICall("users.list", form, token, &res)
+	return res, err
+}
+
+// GetUserPresence - Get the presence of a user
+func GetUserPresence(user string, token string) (SlackPresence, error) {
+	var res SlackPresence
+	form := url.Values{}
+	form.Set("user", user)
+	err := slackAPICall("users.getPresence", form, token, &res)
+	return res, err
+}
+
+// GetUserProfile - Get the profile of a user, user can be empty for the token's user
+func GetUserProfile(user string, token string) (SlackUserProfile, error) {
+	var res struct {
+		Profile SlackUserProfile `json:"profile"`
+	}
+	form := url.Values{}
+	if len(user) > 0 {
+		form.Set("user", user)
+	}
+	err := slackAPICall("users.profile.get", form, token, &res)
+	return res.Profile, err
+}
+
+// SetUserProfile - Set profile fields of a user, user can be empty for the token's user
+func SetUserProfile(user string, fields map[string]interface{}, token string) (SlackUserProfile, error) {
+	var res struct {
+		Profile SlackUserProfile `json:"profile"`
+	}
+	jsonProfile, err := json.Marshal(fields)
+	if err != nil {
+		return res.Profile, err
+	}
+	form := url.Values{}
+	if len(user) > 0 {
+		form.Set("user", user)
+	}
+	form.Set("profile", string(jsonProfile))
+	err = slackAPICall("users.profile.set", form, token, &res)
+	return res.Profile, err
+}
+
+// SetUserStatus - Set the status of a user, a zero expiration keeps the status until changed.
+// user can be empty for the token's user, empty text and emoji clear the status
+func SetUserStatus(user string, text string, emoji string, expiration time.Time, token string) (SlackUserProfile, error) {
+	var expiresAt int64
+	if !expiration.IsZero() {
+		expiresAt = expiration.Unix()
+	}
+	return SetUserProfile(user, map[string]interface{}{
+		"status_text":       text,
+		"status_emoji":      emoji,
+		"status_expiration": expiresAt}, token)
+}
+
+// cachedUser - Cached user lookup, err is set for negative entries
+type cachedUser struct {
+	user    SlackUser
+	err     error
+	expires time.Time
+}
+
+// userLookup - Lookup in flight, shared by the concurrent misses of its key
+type userLookup struct {
+	done chan struct{}
+	user SlackUser
+	err  error
+}
+
+// SlackUserCache - Per workspace TTL cache of user lookups, concurrent misses of the same user share one Slack call
+type SlackUserCache struct {
+	ttl         time.Duration
+	negativeTTL time.Duration
+	mutex       sync.Mutex
+	users       map[string]map[string]cachedUser // workspace -> lookup key -> user
+	inflight    map[string]*userLookup           // workspace and lookup key -> lookup in flight
+}
+
+// InitializeSlackUserCache - Return a user cache keeping found users for ttl and unknown users for negativeTTL
+func InitializeSlackUserCache(ttl time.Duration, negativeTTL time.Duration) *SlackUserCache {
+	return &SlackUserCache{
+		ttl:         ttl,
+		negativeTTL: negativeTTL,
+		users:       make(map[string]map[string]cachedUser),
+		inflight:    make(map[string]*userLookup)}
+}
+
+// isUserNotFound - Whether err means the user does not exist
+func isUserNotFound(err error) bool {
+	apiErr, ok := err.(*SlackAPIError)
+	return ok && (apiErr.Code == "user_not_found" || apiErr.Code == "users_not_found")
+}
+
+// get - Cached lookup of key in workspace
+func (c *SlackUserCache) get(workspace string, key string) (cachedUser, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	entry, ok := c.users[workspace][key]
+	if !ok || time.Now().After(entry.expires) {
+		return cachedUser{}, false
+	}
+	return entry, true
+}
+
+// set - Cache the lookup result of keys in workspace, other errors than not found are not cached
+func (c *SlackUserCache) set(workspace string, keys []string, user SlackUser, err error) {
+	entry := cachedUser{user: user, err: err, expires: time.Now().Add(c.ttl)}
+	if err != nil {
+		if !isUserNotFound(err) {
+			return
+		}
+		entry.expires = time.Now().Add(c.negativeTTL)
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if _, ok := c.users[workspace]; !ok {
+		c.users[workspace] = make(map[string]cachedUser)
+	}
+	now := time.Now()
+	for key, cached := range c.users[workspace] {
+		if now.After(cached.expires) {
+			delete(c.users[workspace], key)
+		}
+	}
+	for _, key := range keys {
+		c.users[workspace][key] = entry
+	}
+}
+
+// lookup - Cached lookup of key in workspace, on a miss fetch is called once for every concurrent caller and returns the user with the keys to cache it under.
+// If fetch panics, the concurrent callers get an error and the next lookup calls fetch again
+func (c *SlackUserCache) lookup(workspace string, key string, fetch func() (SlackUser, []string, error)) (SlackUser, error) {
+	if entry, ok := c.get(workspace, key); ok {
+		return entry.user, entry.err
+	}
+	flightKey := workspace + "/" + key
+	c.mutex.Lock()
+	if call, ok := c.inflight[flightKey]; ok {
+		c.mutex.Unlock()
+		<-call.done
+		return call.user, call.err
+	}
+	call := &userLookup{done: make(chan struct{}), err: fmt.Errorf("slack user lookup of %s did not complete", key)}
+	c.inflight[flightKey] = call
+	c.mutex.Unlock()
+	defer func() {
+		c.mutex.Lock()
+		delete(c.inflight, flightKey)
+		c.mutex.Unlock()
+		close(call.done)
+	}()
+	user, keys, err := fetch()
+	c.set(workspace, keys, user, err)
+	call.user, call.err = user, err
+	return user, err
+}
+
+// FindUserByID - Finding slack user by id through the cache
+func (c *SlackUserCache) FindUserByID(workspace string, id string, token string) (SlackUser, error) {
+	key := "id:" + id
+	return c.lookup(workspace, key, func() (SlackUser, []string, error) {
+		user, err := FindUserByID(id, token)
+		return user, []string{key}, err
+	})
+}
+
+// FindUserByEmail - Finding slack user by email through the cache
+func (c *SlackUserCache) FindUserByEmail(workspace string, email string, token string) (SlackUser, error) {
+	key := "email:" + strings.ToLower(email)
+	return c.lookup(workspace, key, func() (SlackUser, []string, error) {
+		user, err := FindUserByEmail(email, token)
+		keys := []string{key}
+		if err == nil {
+			keys = append(keys, "id:"+user.ID)
+		}
+		return user, keys, err
+	})
+}
+
+// Invalidate - Drop every cached user of workspace
+func (c *SlackUserCache) Invalidate(workspace string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	delete(c.users, workspace)
+}
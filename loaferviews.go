@@ -0,0 +1,142 @@
+package loafer
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const (
+	viewTrackerTTL      = time.Hour              // How long the latest known state of a view is kept at least
+	viewTrackerMaxViews = 10000                  // Views kept per generation of the tracker
+	viewUpdateAttempts  = 3                      // Attempts of ModifyView before giving up on hash conflicts
+	viewConflictBackoff = 200 * time.Millisecond // Wait before retrying with a newer view after a hash conflict
+)
+
+// viewTracker - Latest known state of views, by view id. Views are kept in two generations, the current one becomes
+// the previous one every viewTrackerTTL or once it holds viewTrackerMaxViews, so at most twice that many views are kept
+var viewTracker = struct {
+	sync.Mutex
+	current  map[string]SlackInteractionView
+	previous map[string]SlackInteractionView
+	rotated  time.Time
+}{
+	current:  make(map[string]SlackInteractionView),
+	previous: make(map[string]SlackInteractionView),
+	rotated:  time.Now()}
+
+// rotateViews - Start a new generation of the view tracker when the current one is full or old, the lock must be held
+func rotateViews(now time.Time) {
+	age := now.Sub(viewTracker.rotated)
+	if age < viewTrackerTTL && len(viewTracker.current) < viewTrackerMaxViews {
+		return
+	}
+	viewTracker.previous = viewTracker.current
+	if age >= 2*viewTrackerTTL {
+		viewTracker.previous = make(map[string]SlackInteractionView)
+	}
+	viewTracker.current = make(map[string]SlackInteractionView)
+	viewTracker.rotated = now
+}
+
+// trackView - Record view, from a views.* response or an interaction payload, as the latest state of the view
+func trackView(view SlackInteractionView) {
+	if len(view.ID) == 0 {
+		return
+	}
+	viewTracker.Lock()
+	defer viewTracker.Unlock()
+	rotateViews(time.Now())
+	delete(viewTracker.previous, view.ID)
+	viewTracker.current[view.ID] = view
+}
+
+// forgetView - Drop the state of a closed view
+func forgetView(viewID string) {
+	viewTracker.Lock()
+	defer viewTracker.Unlock()
+	delete(viewTracker.current, viewID)
+	delete(viewTracker.previous, viewID)
+}
+
+// latestView - Latest known state of a view
+func latestView(viewID string) (SlackInteractionView, bool) {
+	viewTracker.Lock()
+	defer viewTracker.Unlock()
+	rotateViews(time.Now())
+	if view, ok := viewTracker.current[viewID]; ok {
+		return view, true
+	}
+	view, ok := viewTracker.previous[viewID]
+	return view, ok
+}
+
+// slackViewCall - Calls a views.* API with view and responds with the resulting view
+func slackViewCall(method string, view interface{}, params map[string]interface{}, token string) (SlackInteractionView, error) {
+	var res struct {
+		View SlackInteractionView `json:"view"`
+	}
+	params["view"] = view
+	err := slackAPICall(method, params, token, &res)
+	if err == nil {
+		trackView(res.View)
+	}
+	return res.View, err
+}
+
+// OpenView - Open view in slack
+func OpenView(view SlackModal, triggerID string, token string) (SlackInteractionView, error) {
+	return slackViewCall("views.open", view, map[string]interface{}{"trigger_id": triggerID}, token)
+}
+
+// PushView - Push view on top of the modal stack
+func PushView(view SlackModal, triggerID string, token string) (SlackInteractionView, error) {
+	return slackViewCall("views.push", view, map[string]interface{}{"trigger_id": triggerID}, token)
+}
+
+// UpdateView - Update a view in slack by viewID or externalID, a non empty hash rejects stale updates
+func UpdateView(view SlackModal, viewID string, externalID string, hash string, token string) (SlackInteractionView, error) {
+	params := map[string]interface{}{}
+	if len(viewID) > 0 {
+		params["view_id"] = viewID
+	}
+	if len(externalID) > 0 {
+		params["external_id"] = externalID
+	}
+	if len(hash) > 0 {
+		params["hash"] = hash
+	}
+	return slackViewCall("views.update", view, params, token)
+}
+
+// PublishHomeView - Publish the App Home view of a user
+func PublishHomeView(userID string, view SlackHomeView, token string) (SlackInteractionView, error) {
+	return slackViewCall("views.publish", view, map[string]interface{}{"user_id": userID}, token)
+}
+
+// ModifyView - Read-modify-write a view, mutate builds the new modal from the view and is re-applied to the latest known view on hash_conflict.
+// Slack has no API to read a view, so the latest view is the one this process last saw in a views.* response or an interaction
+// payload of the app. When no newer hash is known, e.g. the view was updated by another instance of the app, the *SlackAPIError
+// with code hash_conflict is returned without retrying. ctx cancels the wait between attempts
+func ModifyView(ctx context.Context, view SlackInteractionView, mutate func(view SlackInteractionView) SlackModal, token string) (SlackInteractionView, error) {
+	for attempt := 1; ; attempt++ {
+		updated, err := UpdateView(mutate(view), view.ID, "", view.Hash, token)
+		apiErr, isAPIErr := err.(*SlackAPIError)
+		if !isAPIErr || apiErr.Code != "hash_conflict" || attempt == viewUpdateAttempts {
+			return updated, err
+		}
+		if latest, ok := latestView(view.ID); !ok || latest.Hash == view.Hash {
+			return updated, err
+		}
+		select {
+		case <-ctx.Done():
+			return updated, ctx.Err()
+		case <-time.After(time.Duration(attempt) * viewConflictBackoff):
+		}
+		latest, ok := latestView(view.ID)
+		if !ok {
+			return updated, err
+		}
+		view = latest
+	}
+}
@@ -0,0 +1,52 @@
+package loafer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+// SlackWebhookMessage - Message posted to an incoming webhook or an interaction response_url
+type SlackWebhookMessage struct {
+	Text            string `json:"text,omitempty"`
+	Blocks          Blocks `json:"blocks,omitempty"`
+	ThreadTS        string `json:"thread_ts,omitempty"`
+	ResponseType    string `json:"response_type,omitempty"`    // in_channel or ephemeral, response_url only
+	ReplaceOriginal bool   `json:"replace_original,omitempty"` // Replace the message of the interaction, response_url only
+	DeleteOriginal  bool   `json:"delete_original,omitempty"`  // Delete the message of the interaction, response_url only
+}
+
+// PostWebhook - Post a message to an incoming webhook URL or an interaction response_url
+func PostWebhook(webhookURL string, msg SlackWebhookMessage) error {
+	jsonMsg, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	client := InitializeSlackAPIClient("", nil)
+	code, text, err := sendSlackRequest(context.Background(), &client.opts, func() (*http.Request, error) {
+		r, err := http.NewRequest(http.MethodPost, webhookURL, bytes.NewReader(jsonMsg))
+		if err != nil {
+			return nil, err
+		}
+		r.Header.Set("Content-Type", "application/json; charset=utf-8")
+		return r, nil
+	})
+	if err != nil {
+		return err
+	}
+	// Incoming webhooks answer in plain text, response_url answers in JSON
+	var status slackAPIResponse
+	if json.Unmarshal(text, &status) == nil {
+		if status.Ok {
+			return nil
+		}
+		return &SlackAPIError{Method: "webhook", Code: status.Error}
+	}
+	body := strings.TrimSpace(string(text))
+	if code == http.StatusOK && (body == "ok" || len(body) == 0) {
+		return nil
+	}
+	return &SlackAPIError{Method: "webhook", Code: body}
+}
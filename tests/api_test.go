@@ -0,0 +1,119 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/arkjxu/loafer"
+)
+
+func slackAPIServer(t *testing.T, handler func(method string, r *http.Request) (int, string)) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer xoxb-test" {
+			t.Errorf("Unexpected Authorization header: %s", r.Header.Get("Authorization"))
+		}
+		code, body := handler(r.URL.Path[len("/api/"):], r)
+		w.WriteHeader(code)
+		w.Write([]byte(body))
+	}))
+}
+
+func TestCall(t *testing.T) {
+	server := slackAPIServer(t, func(method string, r *http.Request) (int, string) {
+		switch method {
+		case "emoji.list":
+			r.ParseForm()
+			if r.Form.Get("include_categories") != "true" {
+				t.Errorf("Missing form param: %v", r.Form)
+			}
+			return http.StatusOK, `{"ok":true,"emoji":{"party":"https://emoji/party.png"}}`
+		case "chat.postMessage":
+			var params map[string]string
+			json.NewDecoder(r.Body).Decode(&params)
+			if r.Header.Get("Content-Type") != "application/json; charset=utf-8" || params["channel"] != "C1" {
+				t.Errorf("Unexpected JSON request: %s %v", r.Header.Get("Content-Type"), params)
+			}
+			return http.StatusOK, `{"ok":true,"channel":"C1","ts":"1.2"}`
+		default:
+			return http.StatusOK, `{"ok":false,"error":"unknown_method"}`
+		}
+	})
+	defer server.Close()
+	client := loafer.InitializeSlackAPIClient("xoxb-test", &loafer.SlackAPIClientOptions{BaseURL: server.URL + "/api/"})
+	var emoji struct {
+		Emoji map[string]string `json:"emoji"`
+	}
+	err := client.Call(context.Background(), "emoji.list", url.Values{"include_categories": {"true"}}, &emoji)
+	if err != nil || emoji.Emoji["party"] != "https://emoji/party.png" {
+		t.Errorf("Unexpected emoji.list result: %v %v", emoji, err)
+	}
+	var posted loafer.SlackChatResponse
+	err = client.Call(context.Background(), "chat.postMessage", struct {
+		Channel string `json:"channel"`
+		Text    string `json:"text"`
+	}{"C1", "hello"}, &posted)
+	if err != nil || posted.TS != "1.2" {
+		t.Errorf("Unexpected chat.postMessage result: %v %v", posted, err)
+	}
+	err = client.Call(context.Background(), "nope.nope", nil, nil)
+	if apiErr, ok := err.(*loafer.SlackAPIError); !ok || apiErr.Method != "nope.nope" || apiErr.Code != "unknown_method" {
+		t.Errorf("Expected unknown_method, got %v", err)
+	}
+}
+
+func TestCallRateLimited(t *testing.T) {
+	calls := 0
+	server := slackAPIServer(t, func(method string, r *http.Request) (int, string) {
+		calls++
+		if calls == 1 {
+			return http.StatusTooManyRequests, `{"ok":false,"error":"ratelimited"}`
+		}
+		return http.StatusOK, `{"ok":true}`
+	})
+	defer server.Close()
+	client := loafer.InitializeSlackAPIClient("xoxb-test", &loafer.SlackAPIClientOptions{BaseURL: server.URL + "/api/"})
+	err := client.Call(context.Background(), "auth.test", nil, nil)
+	if err != nil || calls != 2 {
+		t.Errorf("Expected a retry after rate limit, got %d calls and %v", calls, err)
+	}
+	calls = 0
+	client = loafer.InitializeSlackAPIClient("xoxb-test", &loafer.SlackAPIClientOptions{BaseURL: server.URL + "/api/", MaxRetries: -1})
+	err = client.Call(context.Background(), "auth.test", nil, nil)
+	if apiErr, ok := err.(*loafer.SlackAPIError); !ok || apiErr.Code != "ratelimited" || calls != 1 {
+		t.Errorf("Expected ratelimited without retry, got %d calls and %v", calls, err)
+	}
+}
+
+func TestPostMessageEncoding(t *testing.T) {
+	var contentType string
+	var blocks string
+	server := slackAPIServer(t, func(method string, r *http.Request) (int, string) {
+		contentType = r.Header.Get("Content-Type")
+		if contentType == "application/json; charset=utf-8" {
+			var params map[string]json.RawMessage
+			json.NewDecoder(r.Body).Decode(&params)
+			blocks = string(params["blocks"])
+		} else {
+			r.ParseForm()
+			blocks = r.Form.Get("blocks")
+		}
+		return http.StatusOK, `{"ok":true,"channel":"C1","ts":"1.2"}`
+	})
+	defer server.Close()
+	expectedBlocks := `[{"type":"divider"}]`
+	loafer.SetDefaultAPIClientOptions(loafer.SlackAPIClientOptions{BaseURL: server.URL + "/api/"})
+	defer loafer.SetDefaultAPIClientOptions(loafer.SlackAPIClientOptions{})
+	posted, err := loafer.PostMessage("C1", []loafer.Block{loafer.MakeSlackDivider()}, "hello", nil, "xoxb-test")
+	if err != nil || posted.TS != "1.2" || blocks != expectedBlocks || contentType != "application/json; charset=utf-8" {
+		t.Errorf("Unexpected JSON post: %s %s %v", contentType, blocks, err)
+	}
+	loafer.SetDefaultAPIClientOptions(loafer.SlackAPIClientOptions{BaseURL: server.URL + "/api/", FormMethods: []string{"chat.postMessage"}})
+	_, err = loafer.PostMessage("C1", []loafer.Block{loafer.MakeSlackDivider()}, "hello", nil, "xoxb-test")
+	if err != nil || blocks != expectedBlocks || contentType != "application/x-www-form-urlencoded" {
+		t.Errorf("Unexpected form post: %s %s %v", contentType, blocks, err)
+	}
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/arkjxu/loafer"
+)
+
+const testSigningSecret = "test-signing-secret"
+
+// signedPost - POST body to the app the way Slack signs its requests, an empty secret sends a bad signature
+func signedPost(t *testing.T, url string, contentType string, body string, secret string) (int, string) {
+	ts := strconv.FormatInt(time.Now().Unix(), 10)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte("v0:" + ts + ":" + body))
+	r, _ := http.NewRequest("POST", url, strings.NewReader(body))
+	r.Header.Set("Content-Type", contentType)
+	r.Header.Set("X-Slack-Request-TimeStamp", ts)
+	r.Header.Set("X-Slack-Signature", "v0="+hex.EncodeToString(mac.Sum(nil)))
+	resp, err := http.DefaultClient.Do(r)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	defer resp.Body.Close()
+	text, _ := ioutil.ReadAll(resp.Body)
+	return resp.StatusCode, string(text)
+}
+
+// testApp - App serving prefix "test" with a bot token for T1 and a user token for U1, cacheReads counts TokensCache calls
+func testApp(cacheReads *int) loafer.SlackApp {
+	return loafer.InitializeSlackApp(&loafer.SlackAppOptions{
+		Name:          "Test Bot",
+		Prefix:        "test",
+		SigningSecret: testSigningSecret,
+		TokensCache: func(workspace string) []loafer.SlackAuthToken {
+			*cacheReads++
+			return []loafer.SlackAuthToken{
+				{Workspace: "T1", Token: "xoxb-test"},
+				{Workspace: "T1", Token: "xoxp-u1", User: "U1"}}
+		}})
+}
+
+func TestCommandTokens(t *testing.T) {
+	cacheReads := 0
+	app := testApp(&cacheReads)
+	tokens := map[string]string{}
+	app.OnCommand("/deploy", func(ctx *loafer.SlackContext) {
+		tokens[ctx.Token] = ctx.UserToken
+		loafer.Response(ctx, http.StatusOK, nil, nil)
+	})
+	server := httptest.NewServer(app.Handler())
+	defer server.Close()
+	form := "application/x-www-form-urlencoded"
+	code, _ := signedPost(t, server.URL+"/test/commands", form, "command=%2Fdeploy&team_id=T1&user_id=U1", testSigningSecret)
+	if code != http.StatusOK || tokens["xoxb-test"] != "xoxp-u1" || cacheReads != 1 {
+		t.Errorf("Expected bot and user tokens from one cache read, got %d %v after %d reads", code, tokens, cacheReads)
+	}
+	signedPost(t, server.URL+"/test/commands", form, "command=%2Fdeploy&team_id=T1&user_id=U2", testSigningSecret)
+	if tokens["xoxb-test"] != "" {
+		t.Errorf("Expected no user token for U2, got %s", tokens["xoxb-test"])
+	}
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/arkjxu/loafer"
+)
+
+func TestBroadcast(t *testing.T) {
+	var mutex sync.Mutex
+	calls := map[string]int{}
+	dmCalls := []time.Time{}
+	server := slackAPIServer(t, func(method string, r *http.Request) (int, string) {
+		var params map[string]interface{}
+		if r.Header.Get("Content-Type") == "application/json; charset=utf-8" {
+			json.NewDecoder(r.Body).Decode(&params)
+		} else {
+			r.ParseForm()
+			params = map[string]interface{}{"channel": r.Form.Get("channel")}
+		}
+		channel, _ := params["channel"].(string)
+		mutex.Lock()
+		calls[method]++
+		if channel == "U1" || channel == "D1" {
+			dmCalls = append(dmCalls, time.Now())
+		}
+		mutex.Unlock()
+		if method != "chat.delete" && params["metadata"] == nil {
+			t.Errorf("Expected the broadcast metadata on %s", method)
+		}
+		switch {
+		case channel == "C_ARCHIVED", channel == "C1" && method == "chat.update":
+			return http.StatusOK, `{"ok":false,"error":"is_archived"}`
+		case channel == "U1":
+			return http.StatusOK, `{"ok":true,"channel":"D1","ts":"1.1"}`
+		}
+		return http.StatusOK, `{"ok":true,"channel":"` + channel + `","ts":"1.2"}`
+	})
+	defer server.Close()
+	loafer.SetDefaultAPIClientOptions(loafer.SlackAPIClientOptions{BaseURL: server.URL + "/api/"})
+	defer loafer.SetDefaultAPIClientOptions(loafer.SlackAPIClientOptions{})
+	interval := 50 * time.Millisecond
+	opts := loafer.SlackBroadcastOptions{Concurrency: 2, Interval: interval, Message: &loafer.MessageOptions{
+		Metadata: &loafer.SlackMessageMetadata{EventType: "incident", EventPayload: map[string]interface{}{"id": "1"}}}}
+	broadcast := loafer.Broadcast([]string{"C1", "U1", "C_ARCHIVED"}, nil, "Investigating", &opts, "xoxb-test")
+	if broadcast.Results[1].Channel != "D1" || broadcast.Results[1].TS != "1.1" || broadcast.Results[0].Err != nil {
+		t.Errorf("Unexpected broadcast results: %+v", broadcast.Results)
+	}
+	if apiErr, ok := broadcast.Results[2].Err.(*loafer.SlackAPIError); !ok || apiErr.Code != "is_archived" {
+		t.Errorf("Expected is_archived, got %v", broadcast.Results[2].Err)
+	}
+	updated := broadcast.Update(nil, "Resolved", "xoxb-test")
+	if len(updated) != 2 || updated[1].Channel != "D1" || updated[1].Err != nil || broadcast.Results[0].Err == nil || broadcast.Results[1].Err != nil {
+		t.Errorf("Unexpected update results: %+v %+v", updated, broadcast.Results)
+	}
+	deleted := broadcast.Delete("xoxb-test")
+	if len(deleted) != 2 || deleted[0].Err != nil || broadcast.Results[0].TS != "" || broadcast.Results[1].TS != "" {
+		t.Errorf("Unexpected delete results: %+v %+v", deleted, broadcast.Results)
+	}
+	if len(broadcast.Delete("xoxb-test")) != 0 {
+		t.Errorf("Expected deleted messages not to be deleted again")
+	}
+	if calls["chat.postMessage"] != 3 || calls["chat.update"] != 2 || calls["chat.delete"] != 2 {
+		t.Errorf("Unexpected calls: %v", calls)
+	}
+	for i := 1; i < len(dmCalls); i++ {
+		if gap := dmCalls[i].Sub(dmCalls[i-1]); gap < interval-5*time.Millisecond {
+			t.Errorf("Expected calls to the DM target to be paced together, got a %v gap", gap)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"encoding/json"
+	"reflect"
 	"testing"
 
 	"github.com/arkjxu/loafer"
@@ -9,7 +10,7 @@ import (
 
 func TestModal(t *testing.T) {
 	validView := `{"type":"modal","title":{"type":"plain_text","text":"Test Modal"},"submit":{"type":"plain_text","text":"Submit"},"close":{"type":"plain_text","text":"Cancel"},"blocks":[{"type":"context","elements":[{"type":"plain_text","text":"hello"}]},{"type":"input","element":{"type":"timepicker","action_id":"test_picker"},"label":{"type":"plain_text","text":"Test picker","emoji":true}}],"callback_id":"test_callback"}`
-	blocks := []interface{}{}
+	blocks := []loafer.Block{}
 	blocks = append(blocks, loafer.MakeSlackContext("hello"))
 	blocks = append(blocks, loafer.MakeSlackModalTimePickerInput("Test picker", "Please pick a time", "", "test_picker"))
 	view := loafer.MakeSlackModal("Test Modal", "test_callback", blocks, "Submit", "Cancel", false)
@@ -21,3 +22,203 @@ func TestModal(t *testing.T) {
 		t.Errorf("%s", "Slack UI Generation failed, not valid")
 	}
 }
+
+func TestElements(t *testing.T) {
+	validBlocks := `[{"type":"actions","elements":[{"type":"overflow","action_id":"test_overflow","options":[{"text":{"type":"plain_text","text":"Edit","emoji":true},"value":"edit"}],"confirm":{"title":{"type":"plain_text","text":"Sure?"},"text":{"type":"mrkdwn","text":"Really edit"},"confirm":{"type":"plain_text","text":"Yes"},"deny":{"type":"plain_text","text":"No"}}},{"type":"multi_channels_select","action_id":"test_channels","placeholder":{"type":"plain_text","text":"Channels","emoji":true},"initial_channels":["C1"]},{"type":"static_select","action_id":"test_kind","placeholder":{"type":"plain_text","text":"Kind","emoji":true},"options":[{"text":{"type":"plain_text","text":"Edit","emoji":true},"value":"edit"}]}]},{"type":"section","text":{"type":"mrkdwn","text":"When"},"accessory":{"type":"datepicker","action_id":"test_date","initial_date":"2024-07-01"}},{"type":"input","block_id":"test_reason","label":{"type":"plain_text","text":"Reason","emoji":true},"element":{"type":"plain_text_input","action_id":"test_reason","multiline":true,"placeholder":{"type":"plain_text","text":"Why","emoji":true}}},{"type":"input","block_id":"test_amount","label":{"type":"plain_text","text":"Amount","emoji":true},"element":{"type":"number_input","action_id":"test_amount","is_decimal_allowed":false,"placeholder":{"type":"plain_text","text":"0","emoji":true}}}]`
+	overflow := loafer.MakeSlackOverflowMenu([]loafer.SlackInputOption{loafer.MakeSlackInputOption("Edit", "edit")}, "test_overflow")
+	overflow.Confirm = loafer.MakeSlackConfirmDialog("Sure?", "Really edit", "Yes", "No")
+	blocks := []loafer.Block{
+		loafer.MakeSlackActions([]loafer.ActionsElement{
+			overflow,
+			loafer.MakeSlackChannelSelect("Channels", []string{"C1"}, "test_channels", true),
+			loafer.MakeSlackStaticSelect("Kind", []loafer.SlackInputOption{loafer.MakeSlackInputOption("Edit", "edit")}, "test_kind", false)}),
+		loafer.MakeSlackSection("When", loafer.MakeSlackDatePicker("2024-07-01", "test_date")),
+		loafer.MakeSlackInput("Reason", loafer.MakeSlackPlainTextInput("Why", "test_reason", true), "test_reason", false),
+		loafer.MakeSlackInput("Amount", loafer.MakeSlackNumberInput("0", false, "test_amount"), "test_amount", false)}
+	jsonBlocks, err := json.Marshal(blocks)
+	if err != nil {
+		t.Errorf("%v", err)
+	}
+	if string(jsonBlocks) != validBlocks {
+		t.Errorf("Slack element generation failed, got %s", jsonBlocks)
+	}
+}
+
+func TestBlocks(t *testing.T) {
+	validBlocks := `[{"type":"header","text":{"type":"plain_text","text":"Report","emoji":true}},{"type":"image","image_url":"https://img/chart.png","alt_text":"chart","title":{"type":"plain_text","text":"Chart","emoji":true}},{"type":"context","elements":[{"type":"mrkdwn","text":"*hello*"}]},{"type":"rich_text","elements":[{"type":"rich_text_list","style":"bullet","elements":[{"type":"rich_text_section","elements":[{"type":"user","user_id":"U1"},{"type":"text","text":" done","style":{"bold":true}}]}]}]}]`
+	blocks := []loafer.Block{
+		loafer.MakeSlackHeader("Report"),
+		loafer.MakeSlackImage("Chart", "https://img/chart.png", "chart"),
+		loafer.MakeSlackMarkdownContext("*hello*"),
+		loafer.MakeSlackRichText(loafer.MakeSlackRichTextList("bullet", 0, loafer.MakeSlackRichTextSection(
+			loafer.MakeSlackRichTextUser("U1"),
+			loafer.MakeSlackRichTextString(" done", &loafer.SlackRichTextStyle{Bold: true}))))}
+	jsonBlocks, err := json.Marshal(blocks)
+	if err != nil {
+		t.Errorf("%v", err)
+	}
+	if string(jsonBlocks) != validBlocks {
+		t.Errorf("Slack block generation failed, got %s", jsonBlocks)
+	}
+}
+
+func TestDecodeView(t *testing.T) {
+	validView := `{"id":"V1","type":"modal","blocks":[{"type":"section","text":{"type":"mrkdwn","text":"Pick one"},"accessory":{"type":"button","text":{"type":"plain_text","text":"Go"},"action_id":"go"}},{"type":"input","block_id":"name","label":{"type":"plain_text","text":"Name"},"element":{"type":"plain_text_input","action_id":"name"}},{"type":"context","elements":[{"type":"image","image_url":"https://img/a.png","alt_text":"a"},{"type":"sparkle","level":3}]},{"type":"call","call_id":"R1"},{"type":"actions","elements":[{"type":"image","image_url":"https://img/b.png","alt_text":"b"}]}],"hash":"h1"}`
+	var view loafer.SlackInteractionView
+	err := json.Unmarshal([]byte(validView), &view)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	section, ok := view.Blocks[0].(loafer.SlackSectionBlock)
+	if !ok {
+		t.Fatalf("Unexpected block: %#v", view.Blocks[0])
+	}
+	if button, ok := section.Accessory.(loafer.SlackBlockButton); !ok || button.ActionID != "go" {
+		t.Errorf("Unexpected accessory: %#v", section.Accessory)
+	}
+	if input, ok := view.Blocks[1].(loafer.SlackInputBlock); !ok || input.Element.(loafer.SlackPlainTextInput).ActionID != "name" {
+		t.Errorf("Unexpected input: %#v", view.Blocks[1])
+	}
+	if unknown, ok := view.Blocks[3].(loafer.UnknownBlock); !ok || unknown.Type != "call" {
+		t.Errorf("Unexpected unknown block: %#v", view.Blocks[3])
+	}
+	if actions, ok := view.Blocks[4].(loafer.SlackBlockActions); !ok {
+		t.Errorf("Unexpected actions: %#v", view.Blocks[4])
+	} else if misplaced, ok := actions.Elements[0].(loafer.UnknownBlockElement); !ok || misplaced.Type != "image" {
+		t.Errorf("Image in actions block should stay unknown, got %#v", actions.Elements[0])
+	}
+	jsonView, err := json.Marshal(view)
+	if err != nil {
+		t.Errorf("%v", err)
+	}
+	if string(jsonView) != validView {
+		t.Errorf("Slack view round trip failed, got %s", jsonView)
+	}
+}
+
+func TestDecodeMalformedBlocks(t *testing.T) {
+	malformed := []string{
+		`[{"type":"section","accessory":{"type":"number_input","is_decimal_allowed":"x"}}]`,
+		`[{"type":"input","element":{"type":"button","text":5}}]`,
+		`[{"type":"actions","elements":[{"type":"file_input","max_files":"x"}]}]`,
+		`[{"type":"section","accessory":{"type":5}}]`}
+	for _, data := range malformed {
+		func() {
+			defer func() {
+				if r := recover(); r != nil {
+					t.Errorf("Decoding %s panicked: %v", data, r)
+				}
+			}()
+			var blocks loafer.Blocks
+			if err := json.Unmarshal([]byte(data), &blocks); err == nil {
+				t.Errorf("Expected an error decoding %s", data)
+			}
+		}()
+	}
+}
+
+// withoutDefaults - JSON value without the false, null and empty string fields Slack sends as defaults
+func withoutDefaults(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			if field == nil || field == false || field == "" {
+				delete(v, key)
+			} else {
+				v[key] = withoutDefaults(field)
+			}
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = withoutDefaults(item)
+		}
+	}
+	return value
+}
+
+func TestDecodeViewRoundTrip(t *testing.T) {
+	slackView := `{
+		"id": "V0123ABCD",
+		"team_id": "T0123ABCD",
+		"type": "modal",
+		"title": {"type": "plain_text", "text": "Request time off", "emoji": true},
+		"submit": {"type": "plain_text", "text": "Submit", "emoji": true},
+		"close": {"type": "plain_text", "text": "Cancel", "emoji": true},
+		"blocks": [
+			{"type": "header", "block_id": "hdr", "text": {"type": "plain_text", "text": "Time off", "emoji": true}},
+			{"type": "section", "block_id": "kind", "text": {"type": "mrkdwn", "text": "*Kind*", "verbatim": false},
+				"accessory": {"type": "static_select", "action_id": "kind_select",
+					"placeholder": {"type": "plain_text", "text": "Pick", "emoji": true},
+					"initial_option": {"text": {"type": "plain_text", "text": "Vacation", "emoji": true}, "value": "vacation", "description": {"type": "plain_text", "text": "Paid", "emoji": true}},
+					"option_groups": [
+						{"label": {"type": "plain_text", "text": "Paid", "emoji": true}, "options": [{"text": {"type": "plain_text", "text": "Vacation", "emoji": true}, "value": "vacation", "description": {"type": "plain_text", "text": "Paid", "emoji": true}}]},
+						{"label": {"type": "plain_text", "text": "Unpaid", "emoji": true}, "options": [{"text": {"type": "plain_text", "text": "Leave", "emoji": true}, "value": "leave"}]}
+					],
+					"focus_on_load": false}},
+			{"type": "input", "block_id": "reason", "label": {"type": "plain_text", "text": "Reason", "emoji": true}, "optional": false, "dispatch_action": true,
+				"element": {"type": "plain_text_input", "action_id": "reason_input", "initial_value": "Family trip", "multiline": true, "min_length": 5, "max_length": 300,
+					"dispatch_action_config": {"trigger_actions_on": ["on_enter_pressed", "on_character_entered"]}, "focus_on_load": true}},
+			{"type": "input", "block_id": "notify", "label": {"type": "plain_text", "text": "Notify", "emoji": true}, "optional": true, "dispatch_action": false,
+				"element": {"type": "multi_conversations_select", "action_id": "notify_select", "initial_conversations": ["C0123ABCD"], "default_to_current_conversation": true,
+					"filter": {"include": ["public", "private"], "exclude_bot_users": true}, "max_selected_items": 3}},
+			{"type": "input", "block_id": "days", "label": {"type": "plain_text", "text": "Days", "emoji": true}, "optional": false, "dispatch_action": false,
+				"element": {"type": "number_input", "action_id": "days_input", "is_decimal_allowed": false, "initial_value": "2", "min_value": "1"}},
+			{"type": "input", "block_id": "details", "label": {"type": "plain_text", "text": "Details", "emoji": true}, "optional": true, "dispatch_action": false,
+				"element": {"type": "rich_text_input", "action_id": "details_input",
+					"initial_value": {"type": "rich_text", "elements": [{"type": "rich_text_section", "elements": [{"type": "text", "text": "See "}, {"type": "link", "url": "https://example.com", "text": "plan"}]}]}}},
+			{"type": "actions", "block_id": "act", "elements": [
+				{"type": "button", "action_id": "approve", "text": {"type": "plain_text", "text": "Approve", "emoji": true}, "value": "1", "style": "primary", "accessibility_label": "Approve request",
+					"confirm": {"title": {"type": "plain_text", "text": "Sure?"}, "text": {"type": "mrkdwn", "text": "Approve it", "verbatim": false}, "confirm": {"type": "plain_text", "text": "Yes"}, "deny": {"type": "plain_text", "text": "No"}}},
+				{"type": "datepicker", "action_id": "start", "initial_date": "2024-07-01", "placeholder": {"type": "plain_text", "text": "Start", "emoji": true}},
+				{"type": "timepicker", "action_id": "at", "initial_time": "09:30", "timezone": "Europe/Paris"}
+			]},
+			{"type": "context", "block_id": "ctx", "elements": [{"type": "mrkdwn", "text": "<#C0123ABCD>", "verbatim": true}, {"type": "image", "image_url": "https://example.com/a.png", "alt_text": "a"}]},
+			{"type": "rich_text", "block_id": "rt", "elements": [
+				{"type": "rich_text_section", "elements": [
+					{"type": "text", "text": "Back on ", "style": {"bold": true, "underline": true}},
+					{"type": "date", "timestamp": 1719820800, "format": "{date_short}", "fallback": "Jul 1"},
+					{"type": "emoji", "name": "wave", "unicode": "1f44b-1f3fb", "skin_tone": 2},
+					{"type": "user", "user_id": "U0123ABCD"}
+				]},
+				{"type": "rich_text_list", "style": "bullet", "indent": 1, "elements": [{"type": "rich_text_section", "elements": [{"type": "text", "text": "Handover"}]}]}
+			]},
+			{"type": "divider", "block_id": "div"}
+		],
+		"private_metadata": "{\"request\":42}",
+		"callback_id": "time_off",
+		"state": {"values": {"reason": {"reason_input": {"type": "plain_text_input", "value": "Family trip"}}}},
+		"hash": "1719820800.abcdEFGH",
+		"clear_on_close": false,
+		"notify_on_close": true,
+		"previous_view_id": null,
+		"root_view_id": "V0123ABCD",
+		"app_id": "A0123ABCD",
+		"external_id": "",
+		"app_installed_team_id": "T0123ABCD",
+		"bot_id": "B0123ABCD"
+	}`
+	var view loafer.SlackInteractionView
+	err := json.Unmarshal([]byte(slackView), &view)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	for i, block := range view.Blocks {
+		if unknown, ok := block.(loafer.UnknownBlock); ok {
+			t.Errorf("Block %d decoded as unknown %s", i, unknown.Type)
+		}
+	}
+	jsonView, err := json.Marshal(view)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	var expected, got interface{}
+	if err = json.Unmarshal([]byte(slackView), &expected); err != nil {
+		t.Fatalf("%v", err)
+	}
+	if err = json.Unmarshal(jsonView, &got); err != nil {
+		t.Fatalf("%v", err)
+	}
+	if !reflect.DeepEqual(withoutDefaults(expected), withoutDefaults(got)) {
+		t.Errorf("Slack view round trip lost fields, got %s", jsonView)
+	}
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/arkjxu/loafer"
+)
+
+const jsonContent = "application/json"
+
+// metadataEvent - Event callback of a message metadata event for T1
+func metadataEvent(eventType string, metadataField string, metadataType string) string {
+	return `{"type":"event_callback","team_id":"T1","event":{"type":"` + eventType + `","channel_id":"C1","message_ts":"1.2","` +
+		metadataField + `":{"event_type":"` + metadataType + `","event_payload":{"id":"42"}}}}`
+}
+
+func TestEvents(t *testing.T) {
+	cacheReads := 0
+	app := testApp(&cacheReads)
+	received := make(chan loafer.SlackMetadataEvent, 4)
+	app.OnMessageMetadata("deploy", func(ctx *loafer.SlackContext, event loafer.SlackMetadataEvent) {
+		if ctx.Token != "xoxb-test" || ctx.Workspace != "T1" {
+			t.Errorf("Unexpected context: %s %s", ctx.Token, ctx.Workspace)
+		}
+		received <- event
+	})
+	app.OnMessageMetadata("crash", func(ctx *loafer.SlackContext, event loafer.SlackMetadataEvent) {
+		panic("handler bug")
+	})
+	server := httptest.NewServer(app.Handler())
+	defer server.Close()
+	url := server.URL + "/test/events"
+	code, _ := signedPost(t, url, jsonContent, `{"type":"url_verification","challenge":"abc"}`, "wrong-secret")
+	if code != http.StatusUnauthorized {
+		t.Errorf("Expected a bad signature to be rejected, got %d", code)
+	}
+	code, body := signedPost(t, url, jsonContent, `{"type":"url_verification","challenge":"abc"}`, testSigningSecret)
+	if code != http.StatusOK || body != "abc" {
+		t.Errorf("Expected the challenge back, got %d %s", code, body)
+	}
+	signedPost(t, url, jsonContent, metadataEvent("message_metadata_posted", "metadata", "crash"), testSigningSecret)
+	signedPost(t, url, jsonContent, metadataEvent("message_metadata_posted", "metadata", "other"), testSigningSecret)
+	code, _ = signedPost(t, url, jsonContent, `{"type":"event_callback","team_id":"T1","event":{"type":"app_mention"}}`, testSigningSecret)
+	if code != http.StatusOK {
+		t.Errorf("Expected unhandled events to be acknowledged, got %d", code)
+	}
+	for _, eventType := range []string{"message_metadata_posted", "message_metadata_updated"} {
+		field := "metadata"
+		if eventType == "message_metadata_updated" {
+			field = "new_metadata"
+		}
+		code, _ = signedPost(t, url, jsonContent, metadataEvent(eventType, field, "deploy"), testSigningSecret)
+		if code != http.StatusOK {
+			t.Errorf("Expected %s to be acknowledged, got %d", eventType, code)
+		}
+		select {
+		case event := <-received:
+			if event.Type != eventType || event.MetadataEventType() != "deploy" || event.ChannelID != "C1" {
+				t.Errorf("Unexpected event: %+v", event)
+			}
+		case <-time.After(time.Second):
+			t.Errorf("Handler of deploy not called for %s", eventType)
+		}
+	}
+	if len(received) != 0 {
+		t.Errorf("Unexpected extra events: %d", len(received))
+	}
+}
+
+func TestLinkShared(t *testing.T) {
+	unfurled := make(chan map[string]json.RawMessage, 2)
+	api := slackAPIServer(t, func(method string, r *http.Request) (int, string) {
+		var params map[string]json.RawMessage
+		json.NewDecoder(r.Body).Decode(&params)
+		if method == "chat.unfurl" {
+			unfurled <- params
+		}
+		return http.StatusOK, `{"ok":true}`
+	})
+	defer api.Close()
+	loafer.SetDefaultAPIClientOptions(loafer.SlackAPIClientOptions{BaseURL: api.URL + "/api/"})
+	defer loafer.SetDefaultAPIClientOptions(loafer.SlackAPIClientOptions{})
+	cacheReads := 0
+	app := testApp(&cacheReads)
+	app.OnLinkShared("*.example.com", func(ctx *loafer.SlackContext, link string) []loafer.Block {
+		return []loafer.Block{loafer.MakeSlackTextSection("Dashboard " + link)}
+	})
+	app.OnLinkShared("example.com", func(ctx *loafer.SlackContext, link string) []loafer.Block {
+		return nil
+	})
+	app.OnLinkShared("crash.test", func(ctx *loafer.SlackContext, link string) []loafer.Block {
+		panic("handler bug")
+	})
+	server := httptest.NewServer(app.Handler())
+	defer server.Close()
+	url := server.URL + "/test/events"
+	signedPost(t, url, jsonContent, `{"type":"event_callback","team_id":"T1","event":{"type":"link_shared","channel":"C1","message_ts":"1.2","links":[{"domain":"crash.test","url":"https://crash.test/"}]}}`, testSigningSecret)
+	code, _ := signedPost(t, url, jsonContent, `{"type":"event_callback","team_id":"T1","event":{"type":"link_shared","channel":"C1","message_ts":"1.2","links":[`+
+		`{"domain":"dash.example.com","url":"https://dash.example.com/d/1"},`+
+		`{"domain":"Grafana.Example.com","url":"https://Grafana.Example.com/d/2"},`+
+		`{"domain":"example.com","url":"https://example.com/"},`+
+		`{"domain":"other.org","url":"https://other.org/"}]}}`, testSigningSecret)
+	if code != http.StatusOK {
+		t.Errorf("Expected link_shared to be acknowledged, got %d", code)
+	}
+	select {
+	case params := <-unfurled:
+		var unfurls map[string]struct {
+			Blocks []map[string]interface{} `json:"blocks"`
+		}
+		json.Unmarshal(params["unfurls"], &unfurls)
+		if string(params["channel"]) != `"C1"` || string(params["ts"]) != `"1.2"` || len(unfurls) != 2 ||
+			len(unfurls["https://dash.example.com/d/1"].Blocks) != 1 || len(unfurls["https://Grafana.Example.com/d/2"].Blocks) != 1 {
+			t.Errorf("Unexpected chat.unfurl params: %s %s %s", params["channel"], params["ts"], params["unfurls"])
+		}
+	case <-time.After(time.Second):
+		t.Errorf("chat.unfurl not called")
+	}
+}
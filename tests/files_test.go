@@ -0,0 +1,67 @@
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/arkjxu/loafer"
+)
+
+func TestUploadFiles(t *testing.T) {
+	steps := []string{}
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		steps = append(steps, r.URL.Path)
+		switch r.URL.Path {
+		case "/api/files.getUploadURLExternal":
+			r.ParseForm()
+			if r.Form.Get("filename") != "notes.txt" || r.Form.Get("length") != "5" {
+				t.Errorf("Unexpected upload URL request: %v", r.Form)
+			}
+			w.Write([]byte(`{"ok":true,"upload_url":"` + server.URL + `/upload/F1","file_id":"F1"}`))
+		case "/upload/F1":
+			file, _, err := r.FormFile("file")
+			if err != nil {
+				t.Errorf("%v", err)
+				return
+			}
+			content, _ := ioutil.ReadAll(file)
+			if string(content) != "hello" || r.ContentLength <= 5 {
+				t.Errorf("Unexpected upload: %s (%d bytes)", content, r.ContentLength)
+			}
+			w.Write([]byte("OK - 5"))
+		case "/api/files.completeUploadExternal":
+			r.ParseForm()
+			var files []map[string]string
+			json.Unmarshal([]byte(r.Form.Get("files")), &files)
+			if len(files) != 1 || files[0]["id"] != "F1" || r.Form.Get("channels") != "C1" {
+				t.Errorf("Unexpected complete request: %v", r.Form)
+			}
+			w.Write([]byte(`{"ok":true,"files":[{"id":"F1"}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	loafer.SetDefaultAPIClientOptions(loafer.SlackAPIClientOptions{BaseURL: server.URL + "/api/"})
+	defer loafer.SetDefaultAPIClientOptions(loafer.SlackAPIClientOptions{})
+	ids, err := loafer.UploadFiles([]loafer.SlackFileUpload{{
+		Filename: "notes.txt",
+		Reader:   strings.NewReader("hello"),
+		Size:     5}}, &loafer.SlackUploadOptions{Channels: []string{"C1"}}, "xoxb-test")
+	if err != nil || len(ids) != 1 || ids[0] != "F1" {
+		t.Errorf("Unexpected upload result: %v %v", ids, err)
+	}
+	if strings.Join(steps, " ") != "/api/files.getUploadURLExternal /upload/F1 /api/files.completeUploadExternal" {
+		t.Errorf("Unexpected upload steps: %v", steps)
+	}
+	steps = nil
+	_, err = loafer.UploadFiles(nil, nil, "xoxb-test")
+	if err == nil || len(steps) != 0 {
+		t.Errorf("Expected an empty upload to fail without calls, got %v after %v", err, steps)
+	}
+}
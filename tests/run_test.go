@@ -9,13 +9,13 @@ import (
 )
 
 func handleDevCommand(ctx *loafer.SlackContext) {
-	buttons := []loafer.SlackBlockButton{}
+	buttons := []loafer.ActionsElement{}
 	buttons = append(buttons, loafer.SlackBlockButton{
 		Type: "button",
 		Text: &loafer.SlackBlockText{
 			Type:  "plain_text",
 			Text:  "Click Me",
-			Emoji: false,
+			Emoji: nil,
 		},
 		Value:    "Click",
 		ActionID: "clicked_me",
@@ -24,7 +24,7 @@ func handleDevCommand(ctx *loafer.SlackContext) {
 		Type:     "actions",
 		Elements: buttons,
 	}
-	blocks := []loafer.ISlackBlockKitUI{}
+	blocks := []loafer.Block{}
 	blocks = append(blocks, actions)
 	ctx.Res.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(ctx.Res).Encode(loafer.SlackUI{
@@ -36,7 +36,7 @@ func TestRun(t *testing.T) {
 	opts := loafer.SlackAppOptions{
 		Name:          "Dev Bot",
 		Prefix:        "dev",
-		Tokens:        []loafer.SlackAuthToken{},
+		TokensCache:   func(workspace string) []loafer.SlackAuthToken { return nil },
 		SigningSecret: "xxxxxxxxxxxxxxxxxxxxxxxxxxxxx",
 		ClientID:      "xxxxxxxxxxxx.xxxxxxxxxx",
 		ClientSecret:  "xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx"}
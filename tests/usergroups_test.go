@@ -0,0 +1,38 @@
+package main
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/arkjxu/loafer"
+)
+
+func TestSyncUserGroupMembers(t *testing.T) {
+	calls := []string{}
+	server := slackAPIServer(t, func(method string, r *http.Request) (int, string) {
+		calls = append(calls, method)
+		switch method {
+		case "usergroups.users.list":
+			return http.StatusOK, `{"ok":true,"users":["U1","U2"]}`
+		case "usergroups.users.update":
+			r.ParseForm()
+			if r.Form.Get("users") != "U1,U3" {
+				t.Errorf("Expected deduplicated members, got %q", r.Form.Get("users"))
+			}
+			return http.StatusOK, `{"ok":true,"usergroup":{"id":"S1"}}`
+		}
+		return http.StatusOK, `{"ok":false,"error":"unknown_method"}`
+	})
+	defer server.Close()
+	loafer.SetDefaultAPIClientOptions(loafer.SlackAPIClientOptions{BaseURL: server.URL + "/api/"})
+	defer loafer.SetDefaultAPIClientOptions(loafer.SlackAPIClientOptions{})
+	added, removed, err := loafer.SyncUserGroupMembers("S1", []string{"U1", "U3", "U1", "U3"}, "xoxb-test")
+	if err != nil || len(added) != 1 || added[0] != "U3" || len(removed) != 1 || removed[0] != "U2" {
+		t.Errorf("Unexpected sync result: %v %v %v", added, removed, err)
+	}
+	calls = nil
+	_, _, err = loafer.SyncUserGroupMembers("S1", []string{}, "xoxb-test")
+	if err == nil || len(calls) != 0 {
+		t.Errorf("Expected an error without calling Slack for no members, got %v after %v", err, calls)
+	}
+}
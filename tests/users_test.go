@@ -0,0 +1,152 @@
+package main
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/arkjxu/loafer"
+)
+
+// slackTransport - Answer Slack API requests in process instead of sending them to slack.com
+type slackTransport func(method string, r *http.Request) (int, string)
+
+// RoundTrip - Answer the request with the handler's status and body
+func (f slackTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	code, body := f(strings.TrimPrefix(r.URL.Path, "/api/"), r)
+	return &http.Response{
+		StatusCode: code,
+		Header:     http.Header{},
+		Body:       ioutil.NopCloser(strings.NewReader(body)),
+		Request:    r}, nil
+}
+
+// stubSlackAPI - Answer every request of the default transport with handler, return the restore function
+func stubSlackAPI(handler func(method string, r *http.Request) (int, string)) func() {
+	transport := http.DefaultTransport
+	http.DefaultTransport = slackTransport(handler)
+	return func() { http.DefaultTransport = transport }
+}
+
+func TestUserCache(t *testing.T) {
+	lookups := 0
+	defer stubSlackAPI(func(method string, r *http.Request) (int, string) {
+		lookups++
+		if method == "users.lookupByEmail" && r.URL.Query().Get("email") == "ops@example.com" {
+			return http.StatusOK, `{"ok":true,"user":{"id":"U1","profile":{"email":"ops@example.com"}}}`
+		}
+		return http.StatusOK, `{"ok":false,"error":"users_not_found"}`
+	})()
+	cache := loafer.InitializeSlackUserCache(time.Minute, 50*time.Millisecond)
+	user, err := cache.FindUserByEmail("T1", "ops@example.com", "xoxb-test")
+	if err != nil || user.ID != "U1" {
+		t.Errorf("Unexpected lookup result: %v %v", user, err)
+	}
+	cache.FindUserByEmail("T1", "Ops@Example.com", "xoxb-test")
+	cache.FindUserByID("T1", "U1", "xoxb-test")
+	cache.FindUserByEmail("T1", "nobody@example.com", "xoxb-test")
+	_, err = cache.FindUserByEmail("T1", "nobody@example.com", "xoxb-test")
+	if apiErr, ok := err.(*loafer.SlackAPIError); !ok || apiErr.Code != "users_not_found" {
+		t.Errorf("Expected cached users_not_found, got %v", err)
+	}
+	if lookups != 2 {
+		t.Errorf("Expected 2 lookups through the cache, got %d", lookups)
+	}
+	time.Sleep(60 * time.Millisecond)
+	cache.FindUserByEmail("T1", "nobody@example.com", "xoxb-test")
+	cache.Invalidate("T1")
+	cache.FindUserByID("T1", "U1", "xoxb-test")
+	if lookups != 4 {
+		t.Errorf("Expected expired and invalidated entries to be looked up again, got %d lookups", lookups)
+	}
+}
+
+func TestUserCacheCoalescing(t *testing.T) {
+	var mutex sync.Mutex
+	lookups := 0
+	release := make(chan struct{})
+	defer stubSlackAPI(func(method string, r *http.Request) (int, string) {
+		mutex.Lock()
+		lookups++
+		mutex.Unlock()
+		<-release
+		return http.StatusOK, `{"ok":true,"user":{"id":"U1"}}`
+	})()
+	cache := loafer.InitializeSlackUserCache(time.Minute, time.Minute)
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			user, err := cache.FindUserByID("T1", "U1", "xoxb-test")
+			if err != nil || user.ID != "U1" {
+				t.Errorf("Unexpected lookup result: %v %v", user, err)
+			}
+		}()
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	if lookups != 1 {
+		t.Errorf("Expected concurrent misses to share 1 lookup, got %d", lookups)
+	}
+}
+
+func TestUserCachePanic(t *testing.T) {
+	lookups := 0
+	defer stubSlackAPI(func(method string, r *http.Request) (int, string) {
+		lookups++
+		if lookups == 1 {
+			panic("transport failure")
+		}
+		return http.StatusOK, `{"ok":true,"user":{"id":"U1"}}`
+	})()
+	cache := loafer.InitializeSlackUserCache(time.Minute, time.Minute)
+	func() {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Errorf("Expected the lookup panic to reach the caller")
+			}
+		}()
+		cache.FindUserByID("T1", "U1", "xoxb-test")
+	}()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		user, err := cache.FindUserByID("T1", "U1", "xoxb-test")
+		if err != nil || user.ID != "U1" {
+			t.Errorf("Unexpected lookup result: %v %v", user, err)
+		}
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatalf("Lookup after a panicked lookup blocked")
+	}
+}
+
+func TestUserLookupEscaping(t *testing.T) {
+	defer stubSlackAPI(func(method string, r *http.Request) (int, string) {
+		if len(r.URL.Query().Get("token")) > 0 || r.Header.Get("Authorization") != "Bearer xoxb-test" {
+			t.Errorf("Token not sent in the Authorization header only: %s", r.URL.String())
+		}
+		if method == "users.info" && r.URL.Query().Get("user") != "U1&user=U2" {
+			t.Errorf("Unescaped user id: %s", r.URL.RawQuery)
+		}
+		if method == "users.lookupByEmail" && r.URL.Query().Get("email") == "ops+oncall@example.com" {
+			return http.StatusOK, `{"ok":true,"user":{"id":"U1","profile":{"email":"ops+oncall@example.com"}}}`
+		}
+		return http.StatusOK, `{"ok":false,"error":"users_not_found"}`
+	})()
+	user, err := loafer.FindUserByEmail("ops+oncall@example.com", "xoxb-test")
+	if err != nil || user.ID != "U1" {
+		t.Errorf("Unexpected lookup result: %v %v", user, err)
+	}
+	_, err = loafer.FindUserByID("U1&user=U2", "xoxb-test")
+	if apiErr, ok := err.(*loafer.SlackAPIError); !ok || apiErr.Method != "users.info" {
+		t.Errorf("Expected users.info error, got %v", err)
+	}
+}
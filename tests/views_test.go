@@ -0,0 +1,47 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/arkjxu/loafer"
+)
+
+func TestModifyView(t *testing.T) {
+	updates := []string{}
+	server := slackAPIServer(t, func(method string, r *http.Request) (int, string) {
+		var params map[string]json.RawMessage
+		json.NewDecoder(r.Body).Decode(&params)
+		switch method {
+		case "views.open":
+			return http.StatusOK, `{"ok":true,"view":{"id":"V1","type":"modal","hash":"h2"}}`
+		case "views.update":
+			var hash string
+			json.Unmarshal(params["hash"], &hash)
+			updates = append(updates, hash)
+			if hash != "h2" {
+				return http.StatusOK, `{"ok":false,"error":"hash_conflict"}`
+			}
+			return http.StatusOK, `{"ok":true,"view":{"id":"V1","type":"modal","hash":"h3"}}`
+		}
+		return http.StatusOK, `{"ok":false,"error":"unknown_method"}`
+	})
+	defer server.Close()
+	loafer.SetDefaultAPIClientOptions(loafer.SlackAPIClientOptions{BaseURL: server.URL + "/api/"})
+	defer loafer.SetDefaultAPIClientOptions(loafer.SlackAPIClientOptions{})
+	mutate := func(view loafer.SlackInteractionView) loafer.SlackModal {
+		return loafer.MakeSlackModal("Edited", "edit", nil, "Save", "Cancel", false)
+	}
+	_, err := loafer.ModifyView(context.Background(), loafer.SlackInteractionView{ID: "V0", Hash: "h1"}, mutate, "xoxb-test")
+	if apiErr, ok := err.(*loafer.SlackAPIError); !ok || apiErr.Code != "hash_conflict" || len(updates) != 1 {
+		t.Errorf("Expected an immediate hash_conflict without a newer view, got %v after %v", err, updates)
+	}
+	updates = nil
+	loafer.OpenView(loafer.MakeSlackModal("Open", "edit", nil, "Save", "Cancel", false), "trigger", "xoxb-test")
+	updated, err := loafer.ModifyView(context.Background(), loafer.SlackInteractionView{ID: "V1", Hash: "h1"}, mutate, "xoxb-test")
+	if err != nil || updated.Hash != "h3" || len(updates) != 2 || updates[1] != "h2" {
+		t.Errorf("Expected a retry with the newer hash, got %v %v after %v", updated, err, updates)
+	}
+}
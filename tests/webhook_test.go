@@ -0,0 +1,71 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/arkjxu/loafer"
+)
+
+func TestPostWebhook(t *testing.T) {
+	var received loafer.SlackWebhookMessage
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&received)
+		switch r.URL.Path {
+		case "/incoming":
+			w.Write([]byte("ok"))
+		case "/archived":
+			w.WriteHeader(http.StatusGone)
+			w.Write([]byte("channel_is_archived"))
+		case "/response":
+			w.Write([]byte(`{"ok":false,"error":"expired_url"}`))
+		}
+	}))
+	defer server.Close()
+	err := loafer.PostWebhook(server.URL+"/incoming", loafer.SlackWebhookMessage{Text: "hello", ResponseType: loafer.RESPONSEINCHANNEL})
+	if err != nil {
+		t.Errorf("%v", err)
+	}
+	if received.Text != "hello" || received.ResponseType != "in_channel" {
+		t.Errorf("Unexpected webhook payload: %+v", received)
+	}
+	err = loafer.PostWebhook(server.URL+"/archived", loafer.SlackWebhookMessage{Text: "hello"})
+	if apiErr, ok := err.(*loafer.SlackAPIError); !ok || apiErr.Code != "channel_is_archived" {
+		t.Errorf("Expected channel_is_archived, got %v", err)
+	}
+	err = loafer.PostWebhook(server.URL+"/response", loafer.SlackWebhookMessage{ReplaceOriginal: true})
+	if apiErr, ok := err.(*loafer.SlackAPIError); !ok || apiErr.Code != "expired_url" {
+		t.Errorf("Expected expired_url, got %v", err)
+	}
+}
+
+func TestPostToWorkspaceWebhook(t *testing.T) {
+	posted := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		posted = true
+		w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+	var install loafer.SlackOauth2Response
+	err := json.Unmarshal([]byte(`{"ok":true,"access_token":"xoxb-1","team":{"id":"T1"},"incoming_webhook":{"channel":"#ops","channel_id":"C1","url":"`+server.URL+`"}}`), &install)
+	if err != nil {
+		t.Errorf("%v", err)
+	}
+	installed := install.AuthToken()
+	opts := loafer.SlackAppOptions{
+		Name:   "Dev Bot",
+		Prefix: "dev",
+		TokensCache: func(workspace string) []loafer.SlackAuthToken {
+			return []loafer.SlackAuthToken{installed}
+		}}
+	app := loafer.InitializeSlackApp(&opts)
+	err = app.PostToWorkspaceWebhook("T1", loafer.SlackWebhookMessage{Text: "hello"})
+	if err != nil || !posted {
+		t.Errorf("Expected post to installed webhook, got %v", err)
+	}
+	if app.PostToWorkspaceWebhook("T2", loafer.SlackWebhookMessage{Text: "hello"}) == nil {
+		t.Errorf("Expected error for workspace without webhook")
+	}
+}